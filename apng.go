@@ -0,0 +1,218 @@
+package vnc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"io"
+	"time"
+)
+
+// pngSignature starts every PNG file.
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// APNGWriter writes frames as an animated PNG that loops forever. Unlike
+// a GIF, an APNG keeps all colors of the frames. Each distinct frame is
+// compressed as it arrives, and the file is written on Close, since its
+// header contains the number of frames.
+type APNGWriter struct {
+	w        io.Writer
+	interval time.Duration
+	dedup    frameDeduper
+	closed   bool
+
+	ihdr   []byte
+	frames []apngFrame
+}
+
+type apngFrame struct {
+	width, height int
+	delay         time.Duration
+	data          []byte
+}
+
+// NewAPNGWriter returns an APNGWriter that writes frames taken at the
+// given frame rate to w. The frame rate must be positive.
+func NewAPNGWriter(w io.Writer, fps float64) (*APNGWriter, error) {
+	interval, err := frameInterval(fps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &APNGWriter{
+		w:        w,
+		interval: interval,
+	}, nil
+}
+
+func (a *APNGWriter) WriteFrame(img image.Image) error {
+	if a.closed {
+		return errWriterClosed
+	}
+
+	if prev, count := a.dedup.add(img); prev != nil {
+		return a.addFrame(prev, count)
+	}
+
+	return nil
+}
+
+func (a *APNGWriter) Close() error {
+	if a.closed {
+		return errWriterClosed
+	}
+	a.closed = true
+
+	if prev, count := a.dedup.flush(); prev != nil {
+		if err := a.addFrame(prev, count); err != nil {
+			return err
+		}
+	}
+
+	if len(a.frames) == 0 {
+		return errors.New("no frames to write")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(pngSignature)
+	writePNGChunk(&buf, "IHDR", a.ihdr)
+
+	actl := make([]byte, 8)
+	binary.BigEndian.PutUint32(actl, uint32(len(a.frames)))
+	writePNGChunk(&buf, "acTL", actl)
+
+	// Frame control and frame data chunks share a sequence number.
+	var seq uint32
+	for i, frame := range a.frames {
+		num, den := apngDelay(frame.delay)
+		fctl := make([]byte, 26)
+		binary.BigEndian.PutUint32(fctl[0:], seq)
+		binary.BigEndian.PutUint32(fctl[4:], uint32(frame.width))
+		binary.BigEndian.PutUint32(fctl[8:], uint32(frame.height))
+		binary.BigEndian.PutUint16(fctl[20:], num)
+		binary.BigEndian.PutUint16(fctl[22:], den)
+		writePNGChunk(&buf, "fcTL", fctl)
+		seq++
+
+		// The first frame is the default image, in IDAT chunks.
+		if i == 0 {
+			writePNGChunk(&buf, "IDAT", frame.data)
+			continue
+		}
+
+		fdat := make([]byte, 4+len(frame.data))
+		binary.BigEndian.PutUint32(fdat, seq)
+		copy(fdat[4:], frame.data)
+		writePNGChunk(&buf, "fdAT", fdat)
+		seq++
+	}
+
+	writePNGChunk(&buf, "IEND", nil)
+
+	_, err := a.w.Write(buf.Bytes())
+	return err
+}
+
+// addFrame compresses a frame by encoding it as a PNG and keeping its
+// image data.
+func (a *APNGWriter) addFrame(img *image.RGBA, count int) error {
+	// Frames must be opaque, so that every frame is encoded with the
+	// same color type.
+	for i := 3; i < len(img.Pix); i += 4 {
+		img.Pix[i] = 0xff
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+
+	ihdr, data, err := splitPNG(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if a.ihdr == nil {
+		a.ihdr = ihdr
+	} else if !bytes.Equal(a.ihdr[8:], ihdr[8:]) {
+		return errors.New("frames must have the same color type")
+	}
+
+	a.frames = append(a.frames, apngFrame{
+		width:  img.Rect.Dx(),
+		height: img.Rect.Dy(),
+		delay:  a.interval * time.Duration(count),
+		data:   data,
+	})
+
+	return nil
+}
+
+// apngDelay converts a duration to the fraction of seconds used for
+// frame delays.
+func apngDelay(d time.Duration) (uint16, uint16) {
+	ms := d / time.Millisecond
+	if ms <= 0xffff {
+		return uint16(ms), 1000
+	}
+
+	cs := d / (10 * time.Millisecond)
+	if cs > 0xffff {
+		cs = 0xffff
+	}
+
+	return uint16(cs), 100
+}
+
+// splitPNG returns the IHDR chunk data and the concatenated IDAT chunk
+// data of a PNG file.
+func splitPNG(b []byte) ([]byte, []byte, error) {
+	if !bytes.HasPrefix(b, []byte(pngSignature)) {
+		return nil, nil, errors.New("not a PNG file")
+	}
+	b = b[len(pngSignature):]
+
+	var ihdr, idat []byte
+	for len(b) >= 12 {
+		length := int(binary.BigEndian.Uint32(b))
+		if 12+length > len(b) {
+			break
+		}
+
+		typ, data := string(b[4:8]), b[8:8+length]
+		switch typ {
+		case "IHDR":
+			ihdr = data
+		case "IDAT":
+			idat = append(idat, data...)
+		}
+
+		b = b[12+length:]
+	}
+
+	if ihdr == nil || idat == nil {
+		return nil, nil, errors.New("invalid PNG file")
+	}
+
+	return ihdr, idat, nil
+}
+
+// writePNGChunk writes a PNG chunk with its length and checksum.
+func writePNGChunk(w *bytes.Buffer, typ string, data []byte) {
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(data)))
+	copy(header[4:], typ)
+	w.Write(header[:])
+	w.Write(data)
+
+	crc := crc32.NewIEEE()
+	crc.Write(header[4:])
+	crc.Write(data)
+
+	var sum [4]byte
+	binary.BigEndian.PutUint32(sum[:], crc.Sum32())
+	w.Write(sum[:])
+}
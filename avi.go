@@ -0,0 +1,278 @@
+package vnc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"io"
+	"math"
+)
+
+// The offsets of the fields of the AVI header that are only known once
+// all frames have been written. See writeHeader for the layout.
+const (
+	aviRIFFSize       = 4
+	aviTotalFrames    = 48
+	aviAvihBufferSize = 60
+	aviStreamLength   = 140
+	aviStrhBufferSize = 144
+	aviMoviSize       = 216
+	aviMoviFourCC     = 220
+	aviHeaderLength   = 224
+)
+
+const (
+	aviHasIndexFlag   = 0x10 // in the main header
+	aviKeyframeFlag   = 0x10 // in index entries
+	aviFrameRateScale = 1000 // frame rate denominator
+	aviBitmapInfoSize = 40
+)
+
+// ErrAVITooLarge is returned by AVIWriter's WriteFrame if the frame would
+// make the file larger than the 4GB that the sizes in an AVI file can
+// describe. The frame is not written, and the writer can still be closed
+// to complete the file, after which the recording can continue in a new
+// file.
+var ErrAVITooLarge = errors.New("AVI file would be larger than 4GB")
+
+// AVIWriter writes frames as a Motion-JPEG AVI video, which most video
+// players can open. Since videos have a constant frame rate, identical
+// frames are repeated without being compressed again.
+type AVIWriter struct {
+	w        io.WriteSeeker
+	fps      float64
+	opts     *jpeg.Options
+	closed   bool
+	started  bool
+	size     image.Rectangle
+	pos      int64
+	maxChunk int
+
+	last     *image.RGBA
+	lastJPEG []byte
+	index    []aviIndexEntry
+}
+
+type aviIndexEntry struct {
+	offset uint32
+	length uint32
+}
+
+// NewAVIWriter returns an AVIWriter that writes frames taken at the given
+// frame rate to w. The header is written with the first frame, and
+// completed on Close, so w must be seekable. The frame rate must be
+// positive. opts sets the JPEG quality, and may be nil to use the default
+// quality.
+func NewAVIWriter(w io.WriteSeeker, fps float64, opts *jpeg.Options) (*AVIWriter, error) {
+	if _, err := frameInterval(fps); err != nil {
+		return nil, err
+	}
+
+	// The frame rate is stored as a fraction of 32-bit integers, which
+	// must not round to zero, and the time between frames in 32-bit
+	// microseconds.
+	rate := fps*aviFrameRateScale + 0.5
+	if rate > math.MaxUint32 {
+		return nil, fmt.Errorf("frame rate too high for AVI: %v", fps)
+	}
+	if rate < 1 || 1000000/fps > math.MaxUint32 {
+		return nil, fmt.Errorf("frame rate too low for AVI: %v", fps)
+	}
+
+	return &AVIWriter{
+		w:    w,
+		fps:  fps,
+		opts: opts,
+	}, nil
+}
+
+func (a *AVIWriter) WriteFrame(img image.Image) error {
+	if a.closed {
+		return errWriterClosed
+	}
+
+	if !a.started {
+		a.size = image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy())
+		if a.size.Dx() > math.MaxUint16 || a.size.Dy() > math.MaxUint16 ||
+			uint64(a.size.Dx())*uint64(a.size.Dy())*3 > math.MaxUint32 {
+			return fmt.Errorf("frame too large for AVI: %dx%d", a.size.Dx(), a.size.Dy())
+		}
+
+		if err := a.writeHeader(); err != nil {
+			return err
+		}
+		a.started = true
+	}
+
+	frame := image.NewRGBA(a.size)
+	draw.Draw(frame, a.size, img, img.Bounds().Min, draw.Src)
+
+	if a.last == nil || !bytes.Equal(a.last.Pix, frame.Pix) {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, frame, a.opts); err != nil {
+			return err
+		}
+
+		a.last = frame
+		a.lastJPEG = buf.Bytes()
+	}
+
+	return a.writeChunk(a.lastJPEG)
+}
+
+func (a *AVIWriter) Close() error {
+	if a.closed {
+		return errWriterClosed
+	}
+	a.closed = true
+
+	if !a.started {
+		return errors.New("no frames to write")
+	}
+
+	moviEnd := a.pos
+
+	var idx bytes.Buffer
+	for _, entry := range a.index {
+		idx.WriteString("00dc")
+		binary.Write(&idx, binary.LittleEndian, []uint32{
+			aviKeyframeFlag, entry.offset, entry.length})
+	}
+
+	if err := a.write(riffChunk("idx1", idx.Bytes())); err != nil {
+		return err
+	}
+
+	patches := []struct {
+		offset int64
+		value  uint32
+	}{
+		{aviRIFFSize, uint32(a.pos - 8)},
+		{aviTotalFrames, uint32(len(a.index))},
+		{aviAvihBufferSize, uint32(a.maxChunk)},
+		{aviStreamLength, uint32(len(a.index))},
+		{aviStrhBufferSize, uint32(a.maxChunk)},
+		{aviMoviSize, uint32(moviEnd - aviMoviFourCC)},
+	}
+
+	for _, p := range patches {
+		if _, err := a.w.Seek(p.offset, io.SeekStart); err != nil {
+			return err
+		}
+
+		if err := binary.Write(a.w, binary.LittleEndian, p.value); err != nil {
+			return err
+		}
+	}
+
+	_, err := a.w.Seek(a.pos, io.SeekStart)
+	return err
+}
+
+// writeHeader writes the RIFF header, the header list with the main AVI
+// header and a single video stream, and the start of the movie list.
+// Sizes and counts that depend on the frames are patched in on Close.
+func (a *AVIWriter) writeHeader() error {
+	width, height := uint32(a.size.Dx()), uint32(a.size.Dy())
+
+	var avih bytes.Buffer
+	binary.Write(&avih, binary.LittleEndian, []uint32{
+		uint32(1000000 / a.fps), // microseconds per frame
+		0,                       // max bytes per second
+		0,                       // padding granularity
+		aviHasIndexFlag,         // flags
+		0,                       // total frames
+		0,                       // initial frames
+		1,                       // streams
+		0,                       // suggested buffer size
+		width,
+		height,
+		0, 0, 0, 0, // reserved
+	})
+
+	var strh bytes.Buffer
+	strh.WriteString("vidsMJPG")
+	binary.Write(&strh, binary.LittleEndian, []uint32{
+		0, // flags
+		0, // priority and language
+		0, // initial frames
+		aviFrameRateScale,
+		uint32(a.fps*aviFrameRateScale + 0.5),
+		0,          // start
+		0,          // length
+		0,          // suggested buffer size
+		0xffffffff, // quality
+		0,          // sample size
+	})
+	binary.Write(&strh, binary.LittleEndian, []uint16{0, 0, uint16(width), uint16(height)})
+
+	var strf bytes.Buffer
+	binary.Write(&strf, binary.LittleEndian, []uint32{aviBitmapInfoSize, width, height})
+	binary.Write(&strf, binary.LittleEndian, []uint16{1, 24}) // planes, bits per pixel
+	strf.WriteString("MJPG")
+	binary.Write(&strf, binary.LittleEndian, []uint32{width * height * 3, 0, 0, 0, 0})
+
+	strl := riffList("strl", riffChunk("strh", strh.Bytes()), riffChunk("strf", strf.Bytes()))
+	hdrl := riffList("hdrl", riffChunk("avih", avih.Bytes()), strl)
+
+	var header bytes.Buffer
+	header.WriteString("RIFF\x00\x00\x00\x00AVI ")
+	header.Write(hdrl)
+	header.WriteString("LIST\x00\x00\x00\x00movi")
+
+	if header.Len() != aviHeaderLength {
+		return fmt.Errorf("bad AVI header length: %d", header.Len())
+	}
+
+	return a.write(header.Bytes())
+}
+
+// writeChunk writes a frame to the movie list and indexes it.
+func (a *AVIWriter) writeChunk(data []byte) error {
+	// The RIFF size covers everything after the RIFF header, including
+	// the index entries that are written on Close, and has 32 bits.
+	end := a.pos + int64(8+len(data)+len(data)%2) + 8 + 16*int64(len(a.index)+1)
+	if end-8 > math.MaxUint32 {
+		return ErrAVITooLarge
+	}
+
+	a.index = append(a.index, aviIndexEntry{
+		offset: uint32(a.pos - aviMoviFourCC),
+		length: uint32(len(data)),
+	})
+
+	if len(data) > a.maxChunk {
+		a.maxChunk = len(data)
+	}
+
+	return a.write(riffChunk("00dc", data))
+}
+
+func (a *AVIWriter) write(b []byte) error {
+	n, err := a.w.Write(b)
+	a.pos += int64(n)
+	return err
+}
+
+// riffChunk returns a RIFF chunk, padded to an even length.
+func riffChunk(fourCC string, data []byte) []byte {
+	chunk := make([]byte, 8+len(data)+len(data)%2)
+	copy(chunk, fourCC)
+	binary.LittleEndian.PutUint32(chunk[4:], uint32(len(data)))
+	copy(chunk[8:], data)
+	return chunk
+}
+
+// riffList returns a RIFF list of the given chunks.
+func riffList(fourCC string, chunks ...[]byte) []byte {
+	data := []byte(fourCC)
+	for _, chunk := range chunks {
+		data = append(data, chunk...)
+	}
+
+	return riffChunk("LIST", data)
+}
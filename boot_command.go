@@ -0,0 +1,266 @@
+package vnc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mitchellh/go-vnc/keysym"
+)
+
+// BootStepType is the kind of a single step of a boot command.
+type BootStepType int
+
+// All available boot step types.
+const (
+	// BootStepPress presses and releases a key.
+	BootStepPress BootStepType = iota
+
+	// BootStepDown presses a key and holds it.
+	BootStepDown
+
+	// BootStepUp releases a held key.
+	BootStepUp
+
+	// BootStepWait waits for a duration.
+	BootStepWait
+)
+
+// A BootStep is a single step of a parsed boot command.
+type BootStep struct {
+	Type BootStepType
+
+	// Keysym is the key to press or release.
+	Keysym uint32
+
+	// Shift is whether shift must be held while pressing the key. It is
+	// only set for BootStepPress steps of typed characters.
+	Shift bool
+
+	// Duration is the time to wait for BootStepWait steps.
+	Duration time.Duration
+}
+
+// bootSpecialKeys maps the lowercased names of special keys in boot
+// commands to their keysyms.
+var bootSpecialKeys = map[string]uint32{
+	"bs":         keysym.BackSpace,
+	"del":        keysym.Delete,
+	"enter":      keysym.Return,
+	"esc":        keysym.Escape,
+	"return":     keysym.Return,
+	"tab":        keysym.Tab,
+	"spacebar":   keysym.Space,
+	"insert":     keysym.Insert,
+	"home":       keysym.Home,
+	"end":        keysym.End,
+	"pageup":     keysym.Page_Up,
+	"pagedown":   keysym.Page_Down,
+	"up":         keysym.Up,
+	"down":       keysym.Down,
+	"left":       keysym.Left,
+	"right":      keysym.Right,
+	"menu":       keysym.Menu,
+	"leftalt":    keysym.Alt_L,
+	"rightalt":   keysym.Alt_R,
+	"leftctrl":   keysym.Control_L,
+	"rightctrl":  keysym.Control_R,
+	"leftshift":  keysym.Shift_L,
+	"rightshift": keysym.Shift_R,
+	"leftsuper":  keysym.Super_L,
+	"rightsuper": keysym.Super_R,
+	"f1":         keysym.F1,
+	"f2":         keysym.F2,
+	"f3":         keysym.F3,
+	"f4":         keysym.F4,
+	"f5":         keysym.F5,
+	"f6":         keysym.F6,
+	"f7":         keysym.F7,
+	"f8":         keysym.F8,
+	"f9":         keysym.F9,
+	"f10":        keysym.F10,
+	"f11":        keysym.F11,
+	"f12":        keysym.F12,
+}
+
+// ParseBootCommand parses a boot command in the format used by Packer,
+// such as "<esc><wait>linux ks=http://example.com/ks.cfg<enter>".
+//
+// Text is typed as is. Special keys are written in angle brackets, such
+// as <enter>, <esc>, <f1> or <leftCtrl>, and are case insensitive. A
+// special key with an "On" or "Off" suffix, such as <leftCtrlOn>, is
+// pressed and held, or released. <wait> waits for one second, while
+// <wait5> and <wait10> wait for the given number of seconds. Any other
+// duration can be given in the format of time.ParseDuration, as in
+// <wait5s> or <wait1m30s>. A wait with an invalid or negative duration,
+// such as <waitforever>, is an error. Other angle brackets that don't form
+// a known special key are typed as is.
+func ParseBootCommand(command string) ([]BootStep, error) {
+	var steps []BootStep
+
+	for len(command) > 0 {
+		if command[0] == '<' {
+			if end := strings.IndexByte(command, '>'); end > 0 {
+				step, ok, err := parseBootSpecial(command[1:end])
+				if err != nil {
+					return nil, err
+				}
+
+				if ok {
+					steps = append(steps, step)
+					command = command[end+1:]
+					continue
+				}
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(command)
+		sym, shift, err := RuneKeysym(r)
+		if err != nil {
+			return nil, err
+		}
+
+		steps = append(steps, BootStep{
+			Type:   BootStepPress,
+			Keysym: sym,
+			Shift:  shift,
+		})
+		command = command[size:]
+	}
+
+	return steps, nil
+}
+
+// parseBootSpecial parses the contents of an angle bracket in a boot
+// command. It returns false if the contents aren't a special key.
+func parseBootSpecial(name string) (BootStep, bool, error) {
+	lower := strings.ToLower(name)
+
+	if strings.HasPrefix(lower, "wait") {
+		d, err := parseBootWait(lower[len("wait"):])
+		if err != nil {
+			return BootStep{}, false, fmt.Errorf("invalid wait <%s>: %s", name, err)
+		}
+
+		return BootStep{Type: BootStepWait, Duration: d}, true, nil
+	}
+
+	if sym, ok := bootSpecialKeys[lower]; ok {
+		return BootStep{Type: BootStepPress, Keysym: sym}, true, nil
+	}
+
+	if sym, ok := bootSpecialKeys[strings.TrimSuffix(lower, "on")]; ok && strings.HasSuffix(lower, "on") {
+		return BootStep{Type: BootStepDown, Keysym: sym}, true, nil
+	}
+
+	if sym, ok := bootSpecialKeys[strings.TrimSuffix(lower, "off")]; ok && strings.HasSuffix(lower, "off") {
+		return BootStep{Type: BootStepUp, Keysym: sym}, true, nil
+	}
+
+	return BootStep{}, false, nil
+}
+
+func parseBootWait(s string) (time.Duration, error) {
+	if s == "" {
+		return time.Second, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if n, atoiErr := strconv.Atoi(s); atoiErr == nil {
+		d, err = time.Duration(n)*time.Second, nil
+	}
+
+	if err == nil && d < 0 {
+		err = errors.New("negative duration")
+	}
+
+	return d, err
+}
+
+// A BootCommandConfig structure is used to configure how a boot command
+// is sent by RunBootCommand.
+type BootCommandConfig struct {
+	// KeyInterval is the time to wait after each key press or release.
+	KeyInterval time.Duration
+}
+
+// RunBootCommand parses the boot command and sends it to the server
+// with KeyEvent. See ParseBootCommand for the format of the command.
+// cfg may be nil.
+//
+// Keys that are still held when the command ends, or when it is cancelled
+// by the context, are released before returning.
+func (c *ClientConn) RunBootCommand(ctx context.Context, command string, cfg *BootCommandConfig) error {
+	steps, err := ParseBootCommand(command)
+	if err != nil {
+		return err
+	}
+
+	return c.RunBootSteps(ctx, steps, cfg)
+}
+
+// RunBootSteps sends already parsed boot command steps to the server.
+// See RunBootCommand.
+func (c *ClientConn) RunBootSteps(ctx context.Context, steps []BootStep, cfg *BootCommandConfig) (err error) {
+	if cfg == nil {
+		cfg = new(BootCommandConfig)
+	}
+
+	held := make(map[uint32]bool)
+	defer func() {
+		for sym := range held {
+			if releaseErr := c.KeyEvent(sym, false); err == nil {
+				err = releaseErr
+			}
+		}
+	}()
+
+	wait := func(d time.Duration) error {
+		if d <= 0 {
+			return ctx.Err()
+		}
+
+		select {
+		case <-time.After(d):
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	for _, step := range steps {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		switch step.Type {
+		case BootStepPress:
+			err = c.typeKey(step.Keysym, step.Shift)
+		case BootStepDown:
+			err = c.KeyEvent(step.Keysym, true)
+			held[step.Keysym] = true
+		case BootStepUp:
+			err = c.KeyEvent(step.Keysym, false)
+			delete(held, step.Keysym)
+		case BootStepWait:
+			if err = wait(step.Duration); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err = wait(cfg.KeyInterval); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package vnc
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/go-vnc/keysym"
+)
+
+func TestParseBootCommand(t *testing.T) {
+	tests := []struct {
+		command string
+		steps   []BootStep
+		isErr   bool
+	}{
+		{
+			"a<enter>",
+			[]BootStep{
+				{Type: BootStepPress, Keysym: keysym.SmallA},
+				{Type: BootStepPress, Keysym: keysym.Return},
+			},
+			false,
+		},
+		{
+			"<ESC><wait><wait5><wait10><wait1m30s><wait250ms>",
+			[]BootStep{
+				{Type: BootStepPress, Keysym: keysym.Escape},
+				{Type: BootStepWait, Duration: time.Second},
+				{Type: BootStepWait, Duration: 5 * time.Second},
+				{Type: BootStepWait, Duration: 10 * time.Second},
+				{Type: BootStepWait, Duration: 90 * time.Second},
+				{Type: BootStepWait, Duration: 250 * time.Millisecond},
+			},
+			false,
+		},
+		{
+			"<leftCtrlOn>C<leftCtrlOff>",
+			[]BootStep{
+				{Type: BootStepDown, Keysym: keysym.Control_L},
+				{Type: BootStepPress, Keysym: keysym.C, Shift: true},
+				{Type: BootStepUp, Keysym: keysym.Control_L},
+			},
+			false,
+		},
+		{
+			"<foo>",
+			[]BootStep{
+				{Type: BootStepPress, Keysym: keysym.Less, Shift: true},
+				{Type: BootStepPress, Keysym: keysym.SmallF},
+				{Type: BootStepPress, Keysym: keysym.SmallO},
+				{Type: BootStepPress, Keysym: keysym.SmallO},
+				{Type: BootStepPress, Keysym: keysym.Greater, Shift: true},
+			},
+			false,
+		},
+		{"<waitforever>", nil, true},
+		{"<wait-5s>", nil, true},
+		{"\x00", nil, true},
+	}
+
+	for _, tt := range tests {
+		steps, err := ParseBootCommand(tt.command)
+		if err != nil && !tt.isErr {
+			t.Fatalf("ParseBootCommand(%q) unexpected error %v", tt.command, err)
+		}
+		if err == nil && tt.isErr {
+			t.Fatalf("ParseBootCommand(%q) expected error", tt.command)
+		}
+		if !reflect.DeepEqual(steps, tt.steps) {
+			t.Errorf("ParseBootCommand(%q) = %v, want %v", tt.command, steps, tt.steps)
+		}
+	}
+}
+
+func TestClientConn_RunBootCommand(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- conn.RunBootCommand(ctx, "<leftAltOn><tab><wait1h>", nil)
+	}()
+
+	expected := []struct {
+		keysym uint32
+		down   bool
+	}{
+		{keysym.Alt_L, true},
+		{keysym.Tab, true},
+		{keysym.Tab, false},
+		// Released when cancelled during the wait
+		{keysym.Alt_L, false},
+	}
+
+	for i, e := range expected {
+		if i == 3 {
+			cancel()
+		}
+
+		var msg [8]byte
+		if _, err := io.ReadFull(server, msg[:]); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if binary.BigEndian.Uint32(msg[4:]) != e.keysym || (msg[1] == 1) != e.down {
+			t.Fatalf("event %d: bad event %v", i, msg)
+		}
+	}
+
+	if err := <-errCh; err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got: %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package vnc
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"sync"
+)
+
+// readBufferSize is the size of the buffer that messages from the server
+// are read through. Large rectangles bypass it and are read directly.
+const readBufferSize = 32 << 10
+
+// readBuffers holds buffers for data that is read in full and decoded
+// before a message is returned, such as the pixels of a rectangle, so
+// that every update doesn't allocate them anew.
+var readBuffers sync.Pool
+
+// getReadBuffer returns a buffer of n bytes from readBuffers. It must be
+// given back with putReadBuffer once its contents aren't used anymore.
+func getReadBuffer(n int) *[]byte {
+	if buf, ok := readBuffers.Get().(*[]byte); ok && cap(*buf) >= n {
+		*buf = (*buf)[:n]
+		return buf
+	}
+
+	buf := make([]byte, n)
+	return &buf
+}
+
+func putReadBuffer(buf *[]byte) {
+	readBuffers.Put(buf)
+}
+
+// readFullBuffer reads n bytes into a buffer from readBuffers. Buffers
+// larger than the pooled one are grown as the data arrives, so that a
+// bogus length from the server doesn't allocate more memory than the
+// data that was actually sent.
+func readFullBuffer(r io.Reader, n int) (*[]byte, error) {
+	buf := getReadBuffer(0)
+	b := *buf
+	if cap(b) < n && cap(b) < readBufferSize {
+		b = make([]byte, 0, readBufferSize)
+	}
+
+	for len(b) < n {
+		if len(b) == cap(b) {
+			b = append(b, 0)[:len(b)]
+		}
+
+		end := cap(b)
+		if end > n {
+			end = n
+		}
+
+		_, err := io.ReadFull(r, b[len(b):end])
+		*buf = b
+		if err != nil {
+			putReadBuffer(buf)
+			return nil, err
+		}
+
+		b = b[:end]
+	}
+
+	*buf = b
+	return buf, nil
+}
+
+// zlibReaders holds zlib readers, which allocate large windows, for
+// decompressing data sent by the server.
+var zlibReaders sync.Pool
+
+// getZlibReader returns a zlib reader from zlibReaders that decompresses
+// data. It must be given back with putZlibReader.
+func getZlibReader(data []byte) (io.ReadCloser, error) {
+	if zr, ok := zlibReaders.Get().(io.ReadCloser); ok {
+		if err := zr.(zlib.Resetter).Reset(bytes.NewReader(data), nil); err != nil {
+			return nil, err
+		}
+
+		return zr, nil
+	}
+
+	return zlib.NewReader(bytes.NewReader(data))
+}
+
+func putZlibReader(zr io.ReadCloser) {
+	zlibReaders.Put(zr)
+}
@@ -0,0 +1,145 @@
+package vnc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// countingReader counts the reads from the underlying reader, which
+// would each be a system call on a network connection.
+type countingReader struct {
+	r     io.Reader
+	reads int
+}
+
+func (r *countingReader) Read(b []byte) (int, error) {
+	r.reads++
+	return r.r.Read(b)
+}
+
+func TestReadBuffer(t *testing.T) {
+	buf, err := readFullBuffer(strings.NewReader("hello world"), 5)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if string(*buf) != "hello" {
+		t.Fatalf("bad data: %q", *buf)
+	}
+	putReadBuffer(buf)
+
+	if buf := getReadBuffer(3); len(*buf) != 3 {
+		t.Fatalf("bad length: %d", len(*buf))
+	}
+
+	if _, err := readFullBuffer(strings.NewReader("hi"), 5); err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected unexpected EOF, got: %v", err)
+	}
+
+	// A bogus length only allocates as much as the data that arrives
+	if _, err := readFullBuffer(strings.NewReader("hi"), 1<<40); err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected unexpected EOF, got: %v", err)
+	}
+
+	buf, err = readFullBuffer(bytes.NewReader(make([]byte, 1<<20)), 1<<20)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(*buf) != 1<<20 {
+		t.Fatalf("bad length: %d", len(*buf))
+	}
+}
+
+// tiledUpdate returns a FramebufferUpdate message of random raw pixels
+// covering a width by height framebuffer with tiles of the given size.
+func tiledUpdate(width, height, tile int, pf PixelFormat) []byte {
+	rnd := rand.New(rand.NewSource(1))
+
+	var buf bytes.Buffer
+	buf.Write([]byte{0, 0})
+	binary.Write(&buf, binary.BigEndian, uint16((width/tile)*(height/tile)))
+	for y := 0; y < height/tile; y++ {
+		for x := 0; x < width/tile; x++ {
+			binary.Write(&buf, binary.BigEndian, []uint16{
+				uint16(x * tile), uint16(y * tile), uint16(tile), uint16(tile)})
+			binary.Write(&buf, binary.BigEndian, int32(0))
+
+			pixels := make([]byte, tile*tile*int(pf.BPP/8))
+			rnd.Read(pixels)
+			buf.Write(pixels)
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// benchmarkServerMessage reads a server message the way the main loop
+// does, and reports the reads from the connection per message. Unless
+// buffered is set, the message is read from the connection directly.
+func benchmarkServerMessage(b *testing.B, c *ClientConn, msg ServerMessage, data []byte, buffered bool) {
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	src := bytes.NewReader(data)
+	cr := &countingReader{r: src}
+	br := bufio.NewReaderSize(cr, readBufferSize)
+	for i := 0; i < b.N; i++ {
+		src.Reset(data)
+
+		var r io.Reader = cr
+		if buffered {
+			br.Reset(cr)
+			r = br
+		}
+
+		var messageType [1]byte
+		if _, err := io.ReadFull(r, messageType[:]); err != nil {
+			b.Fatalf("err: %s", err)
+		}
+
+		if _, err := msg.Read(c, r); err != nil {
+			b.Fatalf("err: %s", err)
+		}
+	}
+
+	b.ReportMetric(float64(cr.reads)/float64(b.N), "reads/op")
+}
+
+func benchmarkFramebufferUpdate(b *testing.B, enc Encoding, tile int, buffered bool) {
+	c := &ClientConn{
+		FrameBufferWidth:  1920,
+		FrameBufferHeight: 1080,
+		PixelFormat:       PixelFormatRGB888,
+		Encs:              []Encoding{enc},
+	}
+	data := tiledUpdate(1920, 1080, tile, c.PixelFormat)
+	benchmarkServerMessage(b, c, new(FramebufferUpdateMessage), data, buffered)
+}
+
+func BenchmarkFramebufferUpdateMessage_Raw(b *testing.B) {
+	benchmarkFramebufferUpdate(b, new(RawEncoding), 120, true)
+}
+
+func BenchmarkFramebufferUpdateMessage_RawImage(b *testing.B) {
+	benchmarkFramebufferUpdate(b, new(RawImageEncoding), 120, true)
+}
+
+func BenchmarkFramebufferUpdateMessage_RawImageSmallTiles(b *testing.B) {
+	benchmarkFramebufferUpdate(b, new(RawImageEncoding), 8, true)
+}
+
+func BenchmarkFramebufferUpdateMessage_RawImageSmallTilesUnbuffered(b *testing.B) {
+	benchmarkFramebufferUpdate(b, new(RawImageEncoding), 8, false)
+}
+
+func BenchmarkServerCutTextMessage_Compressed(b *testing.B) {
+	text := strings.Repeat("The quick brown fox jumps over the lazy dog.\r\n", 1000)
+	data := extendedCutText(uint32(ClipboardProvide)|uint32(ClipboardText), zlibData(text+"\x00"))
+	benchmarkServerMessage(b, new(ClientConn), new(ServerCutTextMessage), data, true)
+}
@@ -5,18 +5,100 @@
 package vnc
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"image"
 	"io"
 	"net"
-	"unicode"
+	"strings"
+	"sync"
+	"sync/atomic"
 )
 
+// ErrClosed is returned when waiting on a connection that was closed.
+var ErrClosed = errors.New("connection closed")
+
+// ErrContinuousUpdatesUnsupported is returned by EnableContinuousUpdates
+// if the server hasn't confirmed support for continuous updates.
+var ErrContinuousUpdatesUnsupported = errors.New("server does not support continuous updates")
+
+// ErrFenceUnsupported is returned by Fence and Sync if the server hasn't
+// confirmed support for fences.
+var ErrFenceUnsupported = errors.New("server does not support fences")
+
+// maxFencePayload is the maximum length of the payload of a fence.
+const maxFencePayload = 64
+
 type ClientConn struct {
 	c      net.Conn
 	config *ClientConfig
 
+	// writeLock serializes client messages so that messages sent from
+	// multiple goroutines are never interleaved on the wire.
+	writeLock sync.Mutex
+
+	// listeners are called from the main loop with every message read
+	// from the server, before it is sent on the ServerMessageCh.
+	listenersLock sync.Mutex
+	listeners     map[int]func(ServerMessage)
+	nextListener  int
+
+	// closed is closed once the main loop exits.
+	closed chan struct{}
+
+	// refresher is the currently running Refresher, if any.
+	refresherLock sync.Mutex
+	refresher     *Refresher
+
+	// serverExts holds the pseudo-encoding types of the protocol
+	// extensions that the server has confirmed it supports.
+	serverExtsLock sync.Mutex
+	serverExts     map[int32]bool
+
+	// The client's clipboard contents in each format, encoded for the
+	// extended clipboard, and the maximum size of each format that the
+	// server accepts without requesting it.
+	clipboardLock        sync.Mutex
+	clipboard            map[ClipboardFormat][]byte
+	serverClipboardSizes map[ClipboardFormat]uint32
+
+	// lastFenceID is used to generate unique payloads for Sync.
+	lastFenceID uint32
+
+	// pointerLock serializes PointerEvent, so that the pointer state
+	// is that of the last event that was sent.
+	pointerLock sync.Mutex
+
+	// The pointer state of the last PointerEvent that was sent. Its lock
+	// is never held while writing, so that screen listeners on the main
+	// loop can read it.
+	pointerStateLock sync.Mutex
+	buttons          ButtonMask
+	pointerX         uint16
+	pointerY         uint16
+
+	// screen is the local copy of the framebuffer, if one is kept.
+	screenLock sync.Mutex
+	screen     *image.RGBA
+
+	// screenListeners are called from the main loop with the areas of
+	// the local copy of the framebuffer that changed.
+	screenListenersLock sync.Mutex
+	screenListeners     map[int]func([]image.Rectangle)
+	nextScreenListener  int
+
+	// converter converts raw pixels for RawImageEncoding. It is only
+	// used from the main loop.
+	converter *pixelConverter
+
+	// formatLock guards the pixel format and the color map, which
+	// SetPixelFormat changes while the main loop decodes messages.
+	formatLock sync.Mutex
+
 	// If the pixel format uses a color map, then this is the color
 	// map that is used. This should not be modified directly, since
 	// the data comes from the server.
@@ -26,10 +108,16 @@ type ClientConn struct {
 	// directly. Instead, SetEncodings should be used.
 	Encs []Encoding
 
-	// Width of the frame buffer in pixels, sent from the server.
+	// sizeLock guards the size of the frame buffer, which changes from
+	// the main loop when the server resizes the desktop.
+	sizeLock sync.Mutex
+
+	// Width of the frame buffer in pixels, sent from the server. Once
+	// the connection is established, use FrameBufferSize to read it.
 	FrameBufferWidth uint16
 
-	// Height of the frame buffer in pixels, sent from the server.
+	// Height of the frame buffer in pixels, sent from the server. Once
+	// the connection is established, use FrameBufferSize to read it.
 	FrameBufferHeight uint16
 
 	// Name associated with the desktop, sent from the server.
@@ -64,12 +152,76 @@ type ClientConfig struct {
 	// This only needs to contain NEW server messages, and doesn't
 	// need to explicitly contain the RFC-required messages.
 	ServerMessages []ServerMessage
+
+	// CutTextPolicy determines how CutText handles characters that can't
+	// be represented in Latin-1. By default, CutText returns an error.
+	CutTextPolicy CutTextPolicy
+
+	// MaxCutTextLength is the maximum length in bytes of the cut text
+	// messages sent by the server, and of each format of extended
+	// clipboard data. Longer cut text is an error that closes the
+	// connection. If zero, the maximum is 16MB.
+	MaxCutTextLength uint32
+
+	// MaxDesktopNameLength is the maximum length in bytes of the desktop
+	// name sent by the server. If zero, the maximum is 4KB.
+	MaxDesktopNameLength uint32
+
+	// MaxFrameBufferPixels is the maximum number of pixels of the frame
+	// buffer, both when connecting and when the server resizes the
+	// desktop. A larger frame buffer is an error that closes the
+	// connection. If zero, the maximum is 8192x8192 pixels.
+	MaxFrameBufferPixels uint32
+}
+
+const (
+	defaultMaxCutTextLength     = 16 << 20
+	defaultMaxDesktopNameLength = 4 << 10
+	defaultMaxFrameBufferPixels = 8192 * 8192
+
+	// maxErrorReasonLength is the maximum length of the reason sent by
+	// the server for a failed handshake that is read.
+	maxErrorReasonLength = 4 << 10
+)
+
+// maxCutTextLength returns the maximum length of cut text from the server.
+func (c *ClientConn) maxCutTextLength() uint32 {
+	if c.config == nil || c.config.MaxCutTextLength == 0 {
+		return defaultMaxCutTextLength
+	}
+
+	return c.config.MaxCutTextLength
+}
+
+// maxDesktopNameLength returns the maximum length of the desktop name.
+func (c *ClientConn) maxDesktopNameLength() uint32 {
+	if c.config == nil || c.config.MaxDesktopNameLength == 0 {
+		return defaultMaxDesktopNameLength
+	}
+
+	return c.config.MaxDesktopNameLength
+}
+
+// checkFrameBufferSize returns an error if a frame buffer of the given
+// size has more pixels than the maximum.
+func (c *ClientConn) checkFrameBufferSize(width, height uint16) error {
+	max := uint32(defaultMaxFrameBufferPixels)
+	if c.config != nil && c.config.MaxFrameBufferPixels != 0 {
+		max = c.config.MaxFrameBufferPixels
+	}
+
+	if uint64(width)*uint64(height) > uint64(max) {
+		return fmt.Errorf("frame buffer too large: %dx%d pixels, maximum is %d", width, height, max)
+	}
+
+	return nil
 }
 
 func Client(c net.Conn, cfg *ClientConfig) (*ClientConn, error) {
 	conn := &ClientConn{
 		c:      c,
 		config: cfg,
+		closed: make(chan struct{}),
 	}
 
 	if err := conn.handshake(); err != nil {
@@ -82,17 +234,43 @@ func Client(c net.Conn, cfg *ClientConfig) (*ClientConn, error) {
 	return conn, nil
 }
 
+// FrameBufferSize returns the current size of the frame buffer. Unlike
+// the FrameBufferWidth and FrameBufferHeight fields, it is safe to call
+// while the server resizes the desktop.
+func (c *ClientConn) FrameBufferSize() (width, height uint16) {
+	c.sizeLock.Lock()
+	defer c.sizeLock.Unlock()
+
+	return c.FrameBufferWidth, c.FrameBufferHeight
+}
+
 func (c *ClientConn) Close() error {
 	return c.c.Close()
 }
 
 // CutText tells the server that the client has new text in its cut buffer.
-// The text string MUST only contain Latin-1 characters. This encoding
-// is compatible with Go's native string format, but can only use up to
-// unicode.MaxLatin values.
+// If the server confirmed support for the extended clipboard, the text is
+// sent as UTF-8 with SetClipboard. Otherwise, the text is converted to
+// Latin-1, which is the only encoding of plain cut text, and characters
+// that Latin-1 can't represent are handled according to the CutTextPolicy
+// of the ClientConfig. Line endings are sent as "\n".
 //
 // See RFC 6143 Section 7.5.6
 func (c *ClientConn) CutText(text string) error {
+	if c.serverSupports(new(ExtendedClipboardPseudoEncoding).Type()) {
+		return c.SetClipboard(map[ClipboardFormat]string{ClipboardText: text})
+	}
+
+	var policy CutTextPolicy
+	if c.config != nil {
+		policy = c.config.CutTextPolicy
+	}
+
+	latin1, err := encodeLatin1(strings.Replace(text, "\r\n", "\n", -1), policy)
+	if err != nil {
+		return err
+	}
+
 	var buf bytes.Buffer
 
 	// This is the fixed size data we'll send
@@ -101,7 +279,8 @@ func (c *ClientConn) CutText(text string) error {
 		uint8(0),
 		uint8(0),
 		uint8(0),
-		uint32(len(text)),
+		uint32(len(latin1)),
+		latin1,
 	}
 
 	for _, val := range fixedData {
@@ -110,22 +289,115 @@ func (c *ClientConn) CutText(text string) error {
 		}
 	}
 
-	for _, char := range text {
-		if char > unicode.MaxLatin1 {
-			return fmt.Errorf("Character '%s' is not valid Latin-1", char)
+	dataLength := 8 + len(latin1)
+	return c.write(buf.Bytes()[0:dataLength])
+}
+
+// EnableContinuousUpdates enables or disables continuous updates for the
+// given region. While enabled, the server sends framebuffer updates for
+// the region without waiting for FramebufferUpdateRequest messages. When
+// continuous updates are disabled, the server sends an
+// EndOfContinuousUpdatesMessage.
+//
+// The ContinuousUpdatesPseudoEncoding must be set with SetEncodings, and
+// the server must have confirmed support, before this can be used.
+func (c *ClientConn) EnableContinuousUpdates(enable bool, x, y, width, height uint16) error {
+	if !c.serverSupports(new(ContinuousUpdatesPseudoEncoding).Type()) {
+		return ErrContinuousUpdatesUnsupported
+	}
+
+	var buf bytes.Buffer
+	var enableByte uint8 = 0
+	if enable {
+		enableByte = 1
+	}
+
+	data := []interface{}{
+		uint8(150),
+		enableByte,
+		x, y, width, height,
+	}
+
+	for _, val := range data {
+		if err := binary.Write(&buf, binary.BigEndian, val); err != nil {
+			return err
 		}
+	}
+
+	return c.write(buf.Bytes()[0:10])
+}
+
+// Fence sends a fence message to the server. The payload is returned
+// unmodified in the response and may be at most 64 bytes long.
+//
+// The FencePseudoEncoding must be set with SetEncodings, and the server
+// must have confirmed support, before this can be used.
+func (c *ClientConn) Fence(flags FenceFlags, payload []byte) error {
+	if !c.serverSupports(new(FencePseudoEncoding).Type()) {
+		return ErrFenceUnsupported
+	}
+
+	if len(payload) > maxFencePayload {
+		return fmt.Errorf("fence payload too long: %d", len(payload))
+	}
+
+	var buf bytes.Buffer
+
+	data := []interface{}{
+		uint8(248),
+		uint8(0),
+		uint8(0),
+		uint8(0),
+		uint32(flags),
+		uint8(len(payload)),
+		payload,
+	}
 
-		if err := binary.Write(&buf, binary.BigEndian, uint8(char)); err != nil {
+	for _, val := range data {
+		if err := binary.Write(&buf, binary.BigEndian, val); err != nil {
 			return err
 		}
 	}
 
-	dataLength := 8 + len(text)
-	if _, err := c.c.Write(buf.Bytes()[0:dataLength]); err != nil {
+	return c.write(buf.Bytes()[0 : 9+len(payload)])
+}
+
+// Sync blocks until all framebuffer updates the server sent in response
+// to previously sent messages have been received. It sends a fence
+// request to the server and waits for the response, which the server
+// sends only after it has handled all prior messages.
+//
+// See Fence for the requirements to use this.
+func (c *ClientConn) Sync(ctx context.Context) error {
+	var payload [4]byte
+	binary.BigEndian.PutUint32(payload[:], atomic.AddUint32(&c.lastFenceID, 1))
+
+	doneCh := make(chan struct{}, 1)
+	remove := c.listen(func(msg ServerMessage) {
+		fence, ok := msg.(*FenceMessage)
+		if !ok || fence.Flags&FenceRequest != 0 || !bytes.Equal(fence.Payload, payload[:]) {
+			return
+		}
+
+		select {
+		case doneCh <- struct{}{}:
+		default:
+		}
+	})
+	defer remove()
+
+	if err := c.Fence(FenceRequest|FenceBlockBefore, payload[:]); err != nil {
 		return err
 	}
 
-	return nil
+	select {
+	case <-doneCh:
+		return nil
+	case <-c.closed:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 // Requests a framebuffer update from the server. There may be an indefinite
@@ -153,20 +425,17 @@ func (c *ClientConn) FramebufferUpdateRequest(incremental bool, x, y, width, hei
 		}
 	}
 
-	if _, err := c.c.Write(buf.Bytes()[0:10]); err != nil {
-		return err
-	}
-
-	return nil
+	return c.write(buf.Bytes()[0:10])
 }
 
 // KeyEvent indiciates a key press or release and sends it to the server.
-// The key is indicated using the X Window System "keysym" value. Use
-// Google to find a reference of these values. To simulate a key press,
-// you must send a key with both a down event, and a non-down event.
+// The key is indicated using the X Window System "keysym" value. The
+// keysym package contains constants for these values. To simulate a key
+// press, you must send a key with both a down event, and a non-down event.
 //
 // See 7.5.4.
 func (c *ClientConn) KeyEvent(keysym uint32, down bool) error {
+	var buf bytes.Buffer
 	var downFlag uint8 = 0
 	if down {
 		downFlag = 1
@@ -181,12 +450,12 @@ func (c *ClientConn) KeyEvent(keysym uint32, down bool) error {
 	}
 
 	for _, val := range data {
-		if err := binary.Write(c.c, binary.BigEndian, val); err != nil {
+		if err := binary.Write(&buf, binary.BigEndian, val); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return c.write(buf.Bytes()[0:8])
 }
 
 // PointerEvent indicates that pointer movement or a pointer button
@@ -195,8 +464,15 @@ func (c *ClientConn) KeyEvent(keysym uint32, down bool) error {
 // The mask is a bitwise mask of various ButtonMask values. When a button
 // is set, it is pressed, when it is unset, it is released.
 //
+// If the server confirmed support for the ExtendedMouseButtonsPseudoEncoding,
+// masks with Button8 or Button9 set are sent with the extended button
+// mask. Otherwise, Button9 can't be sent and is ignored.
+//
 // See RFC 6143 Section 7.5.5
 func (c *ClientConn) PointerEvent(mask ButtonMask, x, y uint16) error {
+	c.pointerLock.Lock()
+	defer c.pointerLock.Unlock()
+
 	var buf bytes.Buffer
 
 	data := []interface{}{
@@ -206,16 +482,33 @@ func (c *ClientConn) PointerEvent(mask ButtonMask, x, y uint16) error {
 		y,
 	}
 
+	// The extended format sets the top bit of the mask to signal that
+	// the buttons from Button8 on follow in an extra byte.
+	dataLength := 6
+	extended := mask&^0x7f != 0 &&
+		c.serverSupports(new(ExtendedMouseButtonsPseudoEncoding).Type())
+	if extended {
+		data[1] = uint8(mask&0x7f) | 0x80
+		data = append(data, uint8(mask>>7))
+		dataLength = 7
+	}
+
 	for _, val := range data {
 		if err := binary.Write(&buf, binary.BigEndian, val); err != nil {
 			return err
 		}
 	}
 
-	if _, err := c.c.Write(buf.Bytes()[0:6]); err != nil {
+	if err := c.write(buf.Bytes()[0:dataLength]); err != nil {
 		return err
 	}
 
+	c.pointerStateLock.Lock()
+	c.buttons = mask
+	c.pointerX = x
+	c.pointerY = y
+	c.pointerStateLock.Unlock()
+
 	return nil
 }
 
@@ -242,7 +535,7 @@ func (c *ClientConn) SetEncodings(encs []Encoding) error {
 	}
 
 	dataLength := 4 + (4 * len(encs))
-	if _, err := c.c.Write(buf.Bytes()[0:dataLength]); err != nil {
+	if err := c.write(buf.Bytes()[0:dataLength]); err != nil {
 		return err
 	}
 
@@ -252,10 +545,17 @@ func (c *ClientConn) SetEncodings(encs []Encoding) error {
 }
 
 // SetPixelFormat sets the format in which pixel values should be sent
-// in FramebufferUpdate messages from the server.
+// in FramebufferUpdate messages from the server. The format is validated
+// with Validate first, and becomes the PixelFormat of the connection.
+// It should be set while no framebuffer update is pending, since updates
+// that are already on their way are decoded with the new format.
 //
 // See RFC 6143 Section 7.5.1
 func (c *ClientConn) SetPixelFormat(format *PixelFormat) error {
+	if err := format.Validate(); err != nil {
+		return err
+	}
+
 	var keyEvent [20]byte
 	keyEvent[0] = 0
 
@@ -268,13 +568,17 @@ func (c *ClientConn) SetPixelFormat(format *PixelFormat) error {
 	copy(keyEvent[4:], pfBytes)
 
 	// Send the data down the connection
-	if _, err := c.c.Write(keyEvent[:]); err != nil {
+	if err := c.write(keyEvent[:]); err != nil {
 		return err
 	}
 
+	c.formatLock.Lock()
+	defer c.formatLock.Unlock()
+
 	// Reset the color map as according to RFC.
 	var newColorMap [256]Color
 	c.ColorMap = newColorMap
+	c.PixelFormat = *format
 
 	return nil
 }
@@ -397,6 +701,10 @@ FindAuth:
 		return err
 	}
 
+	if err = c.checkFrameBufferSize(c.FrameBufferWidth, c.FrameBufferHeight); err != nil {
+		return err
+	}
+
 	// Read the pixel format
 	if err = readPixelFormat(c.c, &c.PixelFormat); err != nil {
 		return err
@@ -407,6 +715,10 @@ FindAuth:
 		return err
 	}
 
+	if nameLength > c.maxDesktopNameLength() {
+		return fmt.Errorf("desktop name too long: %d bytes, maximum is %d", nameLength, c.maxDesktopNameLength())
+	}
+
 	nameBytes := make([]uint8, nameLength)
 	if err = binary.Read(c.c, binary.BigEndian, &nameBytes); err != nil {
 		return err
@@ -420,6 +732,7 @@ FindAuth:
 // mainLoop reads messages sent from the server and routes them to the
 // proper channels for users of the client to read.
 func (c *ClientConn) mainLoop() {
+	defer close(c.closed)
 	defer c.Close()
 
 	// Build the map of available server messages
@@ -430,6 +743,8 @@ func (c *ClientConn) mainLoop() {
 		new(SetColorMapEntriesMessage),
 		new(BellMessage),
 		new(ServerCutTextMessage),
+		new(EndOfContinuousUpdatesMessage),
+		new(FenceMessage),
 	}
 
 	for _, msg := range defaultMessages {
@@ -442,9 +757,13 @@ func (c *ClientConn) mainLoop() {
 		}
 	}
 
+	// Messages are read through a buffer, since they are made of many
+	// small fields.
+	r := bufio.NewReaderSize(c.c, readBufferSize)
+
 	for {
-		var messageType uint8
-		if err := binary.Read(c.c, binary.BigEndian, &messageType); err != nil {
+		messageType, err := r.ReadByte()
+		if err != nil {
 			break
 		}
 
@@ -454,11 +773,20 @@ func (c *ClientConn) mainLoop() {
 			break
 		}
 
-		parsedMsg, err := msg.Read(c, c.c)
+		// The pixel format can't change while a message is decoded and
+		// drawn, but SetPixelFormat doesn't wait for the next message.
+		c.formatLock.Lock()
+		parsedMsg, err := msg.Read(c, r)
+		if err == nil {
+			c.updateScreen(parsedMsg)
+		}
+		c.formatLock.Unlock()
 		if err != nil {
 			break
 		}
 
+		c.notifyListeners(parsedMsg)
+
 		if c.config.ServerMessageCh == nil {
 			continue
 		}
@@ -467,12 +795,83 @@ func (c *ClientConn) mainLoop() {
 	}
 }
 
+// write sends a complete client message to the server. Every client
+// message must be sent with a single call to write.
+func (c *ClientConn) write(data []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	_, err := c.c.Write(data)
+	return err
+}
+
+// setServerSupports records that the server confirmed support for the
+// protocol extension identified by the given pseudo-encoding type.
+func (c *ClientConn) setServerSupports(encType int32) {
+	c.serverExtsLock.Lock()
+	defer c.serverExtsLock.Unlock()
+
+	if c.serverExts == nil {
+		c.serverExts = make(map[int32]bool)
+	}
+
+	c.serverExts[encType] = true
+}
+
+// serverSupports returns whether the server confirmed support for the
+// protocol extension identified by the given pseudo-encoding type.
+func (c *ClientConn) serverSupports(encType int32) bool {
+	c.serverExtsLock.Lock()
+	defer c.serverExtsLock.Unlock()
+
+	return c.serverExts[encType]
+}
+
+// listen registers a function that is called from the main loop with
+// every message read from the server. The returned function removes
+// the listener again. Listeners must not block.
+func (c *ClientConn) listen(f func(ServerMessage)) func() {
+	c.listenersLock.Lock()
+	defer c.listenersLock.Unlock()
+
+	if c.listeners == nil {
+		c.listeners = make(map[int]func(ServerMessage))
+	}
+
+	id := c.nextListener
+	c.nextListener++
+	c.listeners[id] = f
+
+	return func() {
+		c.listenersLock.Lock()
+		defer c.listenersLock.Unlock()
+		delete(c.listeners, id)
+	}
+}
+
+func (c *ClientConn) notifyListeners(msg ServerMessage) {
+	c.listenersLock.Lock()
+	fs := make([]func(ServerMessage), 0, len(c.listeners))
+	for _, f := range c.listeners {
+		fs = append(fs, f)
+	}
+	c.listenersLock.Unlock()
+
+	for _, f := range fs {
+		f(msg)
+	}
+}
+
 func (c *ClientConn) readErrorReason() string {
 	var reasonLen uint32
 	if err := binary.Read(c.c, binary.BigEndian, &reasonLen); err != nil {
 		return "<error>"
 	}
 
+	if reasonLen > maxErrorReasonLength {
+		return fmt.Sprintf("<reason too long: %d bytes>", reasonLen)
+	}
+
 	reason := make([]uint8, reasonLen)
 	if err := binary.Read(c.c, binary.BigEndian, &reason); err != nil {
 		return "<error>"
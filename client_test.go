@@ -1,9 +1,14 @@
 package vnc
 
 import (
+	"bytes"
+	"context"
+	"encoding/binary"
 	"fmt"
+	"io"
 	"net"
 	"testing"
+	"time"
 )
 
 func newMockServer(t *testing.T, version string) string {
@@ -29,6 +34,69 @@ func newMockServer(t *testing.T, version string) string {
 	return ln.Addr().String()
 }
 
+// newTestClient returns a ClientConn connected to the returned server end
+// of an in-memory connection. The server end has already completed the
+// handshake, using no authentication and a 32-bit true color pixel format.
+func newTestClient(t *testing.T, cfg *ClientConfig, width, height uint16) (*ClientConn, net.Conn) {
+	client, server := net.Pipe()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- serveTestHandshake(server, width, height)
+	}()
+
+	c, err := Client(client, cfg)
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	if err := <-errCh; err != nil {
+		t.Fatalf("error in server handshake: %s", err)
+	}
+
+	return c, server
+}
+
+func serveTestHandshake(c net.Conn, width, height uint16) error {
+	var buf [12]byte
+
+	if _, err := c.Write([]byte("RFB 003.008\n")); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(c, buf[:12]); err != nil {
+		return err
+	}
+
+	// One security type: None
+	if _, err := c.Write([]byte{1, 1}); err != nil {
+		return err
+	}
+	if _, err := io.ReadFull(c, buf[:1]); err != nil {
+		return err
+	}
+
+	// SecurityResult: OK
+	if _, err := c.Write([]byte{0, 0, 0, 0}); err != nil {
+		return err
+	}
+
+	// ClientInit
+	if _, err := io.ReadFull(c, buf[:1]); err != nil {
+		return err
+	}
+
+	// ServerInit
+	var init bytes.Buffer
+	binary.Write(&init, binary.BigEndian, width)
+	binary.Write(&init, binary.BigEndian, height)
+	init.Write([]byte{32, 24, 0, 1, 0, 255, 0, 255, 0, 255, 16, 8, 0, 0, 0, 0})
+	binary.Write(&init, binary.BigEndian, uint32(4))
+	init.WriteString("test")
+
+	_, err := c.Write(init.Bytes())
+	return err
+}
+
 func TestClient_LowMajorVersion(t *testing.T) {
 	nc, err := net.Dial("tcp", newMockServer(t, "002.009"))
 	if err != nil {
@@ -93,3 +161,97 @@ func TestParseProtocolVersion(t *testing.T) {
 		}
 	}
 }
+
+func TestClientConn_Sync(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	if err := conn.Sync(context.Background()); err != ErrFenceUnsupported {
+		t.Fatalf("expected ErrFenceUnsupported, got: %v", err)
+	}
+
+	if err := conn.EnableContinuousUpdates(true, 0, 0, 640, 480); err != ErrContinuousUpdatesUnsupported {
+		t.Fatalf("expected ErrContinuousUpdatesUnsupported, got: %v", err)
+	}
+
+	// The server confirms fence support with a request, which the client
+	// must answer with the same payload and the request flag cleared.
+	server.Write([]byte{248, 0, 0, 0, 0x80, 0, 0, 0x03, 2, 'h', 'i'})
+
+	var reply [11]byte
+	if _, err := io.ReadFull(server, reply[:]); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []byte{248, 0, 0, 0, 0, 0, 0, 0x03, 2, 'h', 'i'}
+	if !bytes.Equal(reply[:], expected) {
+		t.Fatalf("bad fence reply: %v", reply)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- conn.Sync(context.Background())
+	}()
+
+	var request [13]byte
+	if _, err := io.ReadFull(server, request[:]); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	flags := FenceFlags(binary.BigEndian.Uint32(request[4:]))
+	if request[0] != 248 || flags != FenceRequest|FenceBlockBefore || request[8] != 4 {
+		t.Fatalf("bad fence request: %v", request)
+	}
+
+	// Answer with an update first, then the fence response
+	server.Write([]byte{0, 0, 0, 0})
+	response := append([]byte{248, 0, 0, 0, 0, 0, 0, 1}, request[8:]...)
+	server.Write(response)
+
+	if err := <-errCh; err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	go io.ReadFull(server, request[:])
+	if err := conn.Sync(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got: %v", err)
+	}
+}
+
+func TestClientConn_EnableContinuousUpdates(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	// Confirm support with an EndOfContinuousUpdates message
+	server.Write([]byte{150})
+
+	errCh := make(chan error, 1)
+	go func() {
+		// The message is handled by the main loop asynchronously, so
+		// retry until it has been seen.
+		for {
+			err := conn.EnableContinuousUpdates(true, 1, 2, 3, 4)
+			if err != ErrContinuousUpdatesUnsupported {
+				errCh <- err
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	var msg [10]byte
+	if _, err := io.ReadFull(server, msg[:]); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := <-errCh; err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []byte{150, 1, 0, 1, 0, 2, 0, 3, 0, 4}
+	if !bytes.Equal(msg[:], expected) {
+		t.Fatalf("bad message: %v", msg)
+	}
+}
@@ -0,0 +1,411 @@
+package vnc
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// ErrExtendedClipboardUnsupported is returned by the extended clipboard
+// methods if the server hasn't confirmed support for the extended
+// clipboard.
+var ErrExtendedClipboardUnsupported = errors.New("server does not support the extended clipboard")
+
+// CutTextPolicy determines how characters that can't be represented in
+// Latin-1 are handled when sending plain cut text.
+type CutTextPolicy int
+
+const (
+	// CutTextError fails with an error.
+	CutTextError CutTextPolicy = iota
+
+	// CutTextReplace replaces the characters with a question mark.
+	CutTextReplace
+
+	// CutTextStrip leaves the characters out.
+	CutTextStrip
+)
+
+// ExtendedClipboardPseudoEncoding declares that the client supports the
+// extended clipboard, which transfers the clipboard as UTF-8 in multiple
+// formats. The server confirms support by sending a ServerCutTextMessage
+// with the ClipboardCaps action, after which CutText uses the extended
+// clipboard and SetClipboard, RequestClipboard and PeekClipboard can be
+// used.
+//
+// This encoding is never sent in a rectangle.
+type ExtendedClipboardPseudoEncoding struct{}
+
+func (*ExtendedClipboardPseudoEncoding) Type() int32 {
+	return -1063131698 // 0xC0A1E5CE
+}
+
+func (e *ExtendedClipboardPseudoEncoding) Read(*ClientConn, *Rectangle, io.Reader) (Encoding, error) {
+	return e, nil
+}
+
+// ClipboardFormat is a bitwise mask of clipboard formats.
+type ClipboardFormat uint32
+
+// The clipboard formats that are supported.
+const (
+	// ClipboardText is UTF-8 text.
+	ClipboardText ClipboardFormat = 1 << 0
+
+	// ClipboardRTF is text in the Rich Text Format.
+	ClipboardRTF ClipboardFormat = 1 << 1
+
+	// ClipboardHTML is an HTML fragment, encoded as UTF-8.
+	ClipboardHTML ClipboardFormat = 1 << 2
+)
+
+// clipboardFormats are the formats that we support.
+const clipboardFormats = ClipboardText | ClipboardRTF | ClipboardHTML
+
+// ClipboardAction is the action of an extended clipboard message.
+type ClipboardAction uint32
+
+// All available clipboard actions.
+const (
+	// ClipboardCaps announces the supported formats and actions, and
+	// the maximum size of each format that may be provided unasked.
+	ClipboardCaps ClipboardAction = 1 << 24
+
+	// ClipboardRequest asks for the data of the given formats, which is
+	// answered with ClipboardProvide.
+	ClipboardRequest ClipboardAction = 1 << 25
+
+	// ClipboardPeek asks which formats are available, which is answered
+	// with ClipboardNotify.
+	ClipboardPeek ClipboardAction = 1 << 26
+
+	// ClipboardNotify announces which formats are available.
+	ClipboardNotify ClipboardAction = 1 << 27
+
+	// ClipboardProvide carries the data of the given formats.
+	ClipboardProvide ClipboardAction = 1 << 28
+)
+
+// ExtendedClipboard is the contents of an extended clipboard message.
+type ExtendedClipboard struct {
+	// Action is the single action of the message. Caps messages may
+	// list further actions, which are in Actions.
+	Action ClipboardAction
+
+	// Actions are the supported actions of a ClipboardCaps message.
+	Actions ClipboardAction
+
+	// Formats are the formats that the message is about.
+	Formats ClipboardFormat
+
+	// MaxSizes are the maximum sizes per format in a ClipboardCaps
+	// message.
+	MaxSizes map[ClipboardFormat]uint32
+
+	// Data is the data per format in a ClipboardProvide message, with
+	// line endings normalized to "\n".
+	Data map[ClipboardFormat]string
+}
+
+// SetClipboard tells the server that the client has new clipboard
+// contents in the given formats. If the contents are small enough, they
+// are sent right away, and otherwise the server is notified and can
+// request them. Formats the server doesn't support are left out.
+//
+// The ExtendedClipboardPseudoEncoding must be set with SetEncodings, and
+// the server must have confirmed support, before this can be used.
+func (c *ClientConn) SetClipboard(data map[ClipboardFormat]string) error {
+	if !c.serverSupports(new(ExtendedClipboardPseudoEncoding).Type()) {
+		return ErrExtendedClipboardUnsupported
+	}
+
+	c.clipboardLock.Lock()
+	defer c.clipboardLock.Unlock()
+
+	c.clipboard = make(map[ClipboardFormat][]byte)
+	var formats ClipboardFormat
+	provide := true
+	for format, value := range data {
+		if format&clipboardFormats == 0 || format&(format-1) != 0 {
+			return fmt.Errorf("unsupported clipboard format: %#x", uint32(format))
+		}
+
+		maxSize, ok := c.serverClipboardSizes[format]
+		if !ok {
+			continue
+		}
+
+		encoded := encodeClipboardData(format, value)
+		if len(encoded) > int(maxSize) {
+			provide = false
+		}
+
+		c.clipboard[format] = encoded
+		formats |= format
+	}
+
+	if provide {
+		return c.provideClipboard(formats)
+	}
+
+	return c.writeExtendedClipboard(uint32(ClipboardNotify)|uint32(formats), nil)
+}
+
+// RequestClipboard asks the server for the clipboard contents in the
+// given formats. The server answers with a ServerCutTextMessage with the
+// ClipboardProvide action.
+//
+// See SetClipboard for the requirements to use this.
+func (c *ClientConn) RequestClipboard(formats ClipboardFormat) error {
+	if !c.serverSupports(new(ExtendedClipboardPseudoEncoding).Type()) {
+		return ErrExtendedClipboardUnsupported
+	}
+
+	return c.writeExtendedClipboard(uint32(ClipboardRequest)|uint32(formats), nil)
+}
+
+// PeekClipboard asks the server which clipboard formats are available.
+// The server answers with a ServerCutTextMessage with the ClipboardNotify
+// action.
+//
+// See SetClipboard for the requirements to use this.
+func (c *ClientConn) PeekClipboard() error {
+	if !c.serverSupports(new(ExtendedClipboardPseudoEncoding).Type()) {
+		return ErrExtendedClipboardUnsupported
+	}
+
+	return c.writeExtendedClipboard(uint32(ClipboardPeek), nil)
+}
+
+// handleExtendedClipboard answers an extended clipboard message from the
+// server. This is called from the main loop.
+func (c *ClientConn) handleExtendedClipboard(msg *ExtendedClipboard) error {
+	switch msg.Action {
+	case ClipboardCaps:
+		c.clipboardLock.Lock()
+		c.serverClipboardSizes = msg.MaxSizes
+		c.clipboardLock.Unlock()
+
+		c.setServerSupports(new(ExtendedClipboardPseudoEncoding).Type())
+
+		// Reply with our own capabilities
+		flags := uint32(ClipboardCaps|ClipboardRequest|ClipboardPeek|ClipboardNotify|ClipboardProvide) |
+			uint32(clipboardFormats)
+
+		var sizes bytes.Buffer
+		for format := ClipboardFormat(1); format&clipboardFormats != 0; format <<= 1 {
+			binary.Write(&sizes, binary.BigEndian, c.maxCutTextLength())
+		}
+
+		return c.writeExtendedClipboard(flags, sizes.Bytes())
+	case ClipboardRequest:
+		c.clipboardLock.Lock()
+		defer c.clipboardLock.Unlock()
+
+		var formats ClipboardFormat
+		for format := range c.clipboard {
+			formats |= format
+		}
+
+		return c.provideClipboard(msg.Formats & formats)
+	case ClipboardPeek:
+		c.clipboardLock.Lock()
+		var formats ClipboardFormat
+		for format := range c.clipboard {
+			formats |= format
+		}
+		c.clipboardLock.Unlock()
+
+		return c.writeExtendedClipboard(uint32(ClipboardNotify)|uint32(formats), nil)
+	case ClipboardNotify:
+		// Ask for the new contents, which arrive as a provide message
+		if formats := msg.Formats & clipboardFormats; formats != 0 {
+			return c.writeExtendedClipboard(uint32(ClipboardRequest)|uint32(formats), nil)
+		}
+	}
+
+	return nil
+}
+
+// provideClipboard sends the given formats of the client's clipboard to
+// the server. The clipboardLock must be held.
+func (c *ClientConn) provideClipboard(formats ClipboardFormat) error {
+	var data bytes.Buffer
+	for format := ClipboardFormat(1); format <= 0x8000; format <<= 1 {
+		if formats&format == 0 {
+			continue
+		}
+
+		value := c.clipboard[format]
+		binary.Write(&data, binary.BigEndian, uint32(len(value)))
+		data.Write(value)
+	}
+
+	// Every message is a separate zlib stream
+	var payload bytes.Buffer
+	zw := zlib.NewWriter(&payload)
+	if _, err := zw.Write(data.Bytes()); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	return c.writeExtendedClipboard(uint32(ClipboardProvide)|uint32(formats), payload.Bytes())
+}
+
+// writeExtendedClipboard sends a ClientCutText message in the extended
+// format, which is marked by a negative length.
+func (c *ClientConn) writeExtendedClipboard(flags uint32, payload []byte) error {
+	var buf bytes.Buffer
+
+	data := []interface{}{
+		uint8(6),
+		uint8(0),
+		uint8(0),
+		uint8(0),
+		-int32(4 + len(payload)),
+		flags,
+		payload,
+	}
+
+	for _, val := range data {
+		if err := binary.Write(&buf, binary.BigEndian, val); err != nil {
+			return err
+		}
+	}
+
+	return c.write(buf.Bytes())
+}
+
+// readExtendedClipboard parses the data of an extended clipboard message.
+// The data of each format may be at most maxSize bytes.
+func readExtendedClipboard(data []byte, maxSize uint32) (*ExtendedClipboard, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("extended clipboard message too short: %d", len(data))
+	}
+
+	flags := binary.BigEndian.Uint32(data)
+	data = data[4:]
+
+	result := &ExtendedClipboard{
+		Formats: ClipboardFormat(flags & 0xffff),
+	}
+
+	// The action is the lowest action bit that is set
+	actions := ClipboardAction(flags &^ 0xffffff)
+	result.Action = actions & -actions
+
+	switch result.Action {
+	case ClipboardCaps:
+		result.Actions = actions
+		result.MaxSizes = make(map[ClipboardFormat]uint32)
+		for format := ClipboardFormat(1); format <= 0x8000; format <<= 1 {
+			if result.Formats&format == 0 {
+				continue
+			}
+
+			if len(data) < 4 {
+				return nil, errors.New("extended clipboard caps too short")
+			}
+
+			result.MaxSizes[format] = binary.BigEndian.Uint32(data)
+			data = data[4:]
+		}
+	case ClipboardProvide:
+		zr, err := getZlibReader(data)
+		if err != nil {
+			return nil, err
+		}
+		defer putZlibReader(zr)
+
+		result.Data = make(map[ClipboardFormat]string)
+		for format := ClipboardFormat(1); format <= 0x8000; format <<= 1 {
+			if result.Formats&format == 0 {
+				continue
+			}
+
+			var size uint32
+			if err := binary.Read(zr, binary.BigEndian, &size); err != nil {
+				return nil, unexpectedEOF(err)
+			}
+
+			if size > maxSize {
+				return nil, fmt.Errorf("clipboard data too long: %d bytes, maximum is %d", size, maxSize)
+			}
+
+			value := make([]byte, size)
+			if _, err := io.ReadFull(zr, value); err != nil {
+				return nil, unexpectedEOF(err)
+			}
+
+			if format&clipboardFormats != 0 {
+				result.Data[format] = decodeClipboardData(value)
+			}
+		}
+	case ClipboardRequest, ClipboardPeek, ClipboardNotify:
+	default:
+		return nil, fmt.Errorf("unknown extended clipboard action: %#x", flags)
+	}
+
+	return result, nil
+}
+
+// encodeClipboardData encodes clipboard data as it is sent, with "\r\n"
+// line endings and a terminating null byte.
+func encodeClipboardData(format ClipboardFormat, value string) []byte {
+	if format == ClipboardText {
+		value = strings.Replace(value, "\r\n", "\n", -1)
+		value = strings.Replace(value, "\n", "\r\n", -1)
+	}
+
+	return append([]byte(value), 0)
+}
+
+// decodeClipboardData decodes clipboard data as it is received, removing
+// the terminating null byte and normalizing line endings to "\n".
+func decodeClipboardData(value []byte) string {
+	if i := bytes.IndexByte(value, 0); i >= 0 {
+		value = value[:i]
+	}
+
+	return strings.Replace(string(value), "\r\n", "\n", -1)
+}
+
+// encodeLatin1 converts UTF-8 text to Latin-1, handling the characters
+// Latin-1 can't represent according to the policy.
+func encodeLatin1(text string, policy CutTextPolicy) ([]byte, error) {
+	result := make([]byte, 0, len(text))
+	for _, r := range text {
+		if r <= unicode.MaxLatin1 {
+			result = append(result, byte(r))
+			continue
+		}
+
+		switch policy {
+		case CutTextReplace:
+			result = append(result, '?')
+		case CutTextStrip:
+		default:
+			return nil, fmt.Errorf("character %q is not valid Latin-1", r)
+		}
+	}
+
+	return result, nil
+}
+
+// decodeLatin1 converts Latin-1 text to UTF-8, normalizing line endings
+// to "\n".
+func decodeLatin1(text []byte) string {
+	runes := make([]rune, len(text))
+	for i, b := range text {
+		runes[i] = rune(b)
+	}
+
+	return strings.Replace(string(runes), "\r\n", "\n", -1)
+}
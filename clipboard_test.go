@@ -0,0 +1,215 @@
+package vnc
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+// extendedCutText returns a ServerCutText message in the extended format.
+func extendedCutText(flags uint32, payload []byte) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{3, 0, 0, 0})
+	binary.Write(&buf, binary.BigEndian, -int32(4+len(payload)))
+	binary.Write(&buf, binary.BigEndian, flags)
+	buf.Write(payload)
+	return buf.Bytes()
+}
+
+// readExtendedCutText reads a ClientCutText message in the extended
+// format, and returns its flags and payload.
+func readExtendedCutText(t *testing.T, conn net.Conn) (uint32, []byte) {
+	var header [12]byte
+	if _, err := io.ReadFull(conn, header[:]); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	length := int32(binary.BigEndian.Uint32(header[4:]))
+	if header[0] != 6 || length > -4 {
+		t.Fatalf("bad message header: %v", header)
+	}
+
+	payload := make([]byte, -length-4)
+	if _, err := io.ReadFull(conn, payload); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return binary.BigEndian.Uint32(header[8:]), payload
+}
+
+// zlibData compresses clipboard data as sent in a provide message.
+func zlibData(values ...string) []byte {
+	var data bytes.Buffer
+	for _, value := range values {
+		binary.Write(&data, binary.BigEndian, uint32(len(value)))
+		data.WriteString(value)
+	}
+
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	zw.Write(data.Bytes())
+	zw.Close()
+	return buf.Bytes()
+}
+
+func TestExtendedClipboard(t *testing.T) {
+	msgCh := make(chan ServerMessage, 10)
+	conn, server := newTestClient(t, &ClientConfig{ServerMessageCh: msgCh}, 8, 8)
+	defer conn.Close()
+
+	if err := conn.RequestClipboard(ClipboardText); err != ErrExtendedClipboardUnsupported {
+		t.Fatalf("expected unsupported error, got: %v", err)
+	}
+
+	// Caps with text and HTML, accepting 1024 bytes of text unasked
+	caps := []byte{0, 0, 4, 0, 0, 0, 0, 0}
+	go server.Write(extendedCutText(uint32(ClipboardCaps|ClipboardRequest|ClipboardProvide)|uint32(ClipboardText|ClipboardHTML), caps))
+
+	flags, payload := readExtendedCutText(t, server)
+	if ClipboardAction(flags)&ClipboardCaps == 0 || ClipboardFormat(flags)&clipboardFormats != clipboardFormats {
+		t.Fatalf("bad caps reply: %#x", flags)
+	}
+	if len(payload) != 12 {
+		t.Fatalf("bad caps sizes: %v", payload)
+	}
+
+	// CutText now sends UTF-8 text in the extended format
+	go conn.CutText("héllo\n世界")
+
+	flags, payload = readExtendedCutText(t, server)
+	if flags != uint32(ClipboardProvide)|uint32(ClipboardText) {
+		t.Fatalf("bad provide flags: %#x", flags)
+	}
+
+	zr, err := zlib.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if string(data[4:]) != "héllo\r\n世界\x00" {
+		t.Fatalf("bad provided text: %q", data)
+	}
+
+	// Data too large for the server's limit is only announced
+	go conn.SetClipboard(map[ClipboardFormat]string{
+		ClipboardText: string(make([]byte, 2000)),
+		ClipboardRTF:  "ignored",
+	})
+
+	flags, _ = readExtendedCutText(t, server)
+	if flags != uint32(ClipboardNotify)|uint32(ClipboardText) {
+		t.Fatalf("bad notify flags: %#x", flags)
+	}
+
+	// A notify from the server is answered with a request
+	go server.Write(extendedCutText(uint32(ClipboardNotify)|uint32(ClipboardText|ClipboardHTML), nil))
+
+	flags, _ = readExtendedCutText(t, server)
+	if flags != uint32(ClipboardRequest)|uint32(ClipboardText|ClipboardHTML) {
+		t.Fatalf("bad request flags: %#x", flags)
+	}
+
+	go server.Write(extendedCutText(
+		uint32(ClipboardProvide)|uint32(ClipboardText|ClipboardHTML),
+		zlibData("über\r\nall\x00", "<b>über</b>\x00")))
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case msg := <-msgCh:
+			cut, ok := msg.(*ServerCutTextMessage)
+			if !ok || cut.Clipboard.Action != ClipboardProvide {
+				continue
+			}
+
+			if cut.Text != "über\nall" {
+				t.Fatalf("bad text: %q", cut.Text)
+			}
+
+			if cut.Clipboard.Data[ClipboardHTML] != "<b>über</b>" {
+				t.Fatalf("bad HTML: %q", cut.Clipboard.Data[ClipboardHTML])
+			}
+
+			return
+		case <-timeout:
+			t.Fatal("timeout waiting for clipboard")
+		}
+	}
+}
+
+func TestServerCutTextMessage_Latin1(t *testing.T) {
+	data := []byte{3, 0, 0, 0, 0, 0, 0, 5, 'h', 'e', 'l', 'l', 'o'}
+	msg, err := new(ServerCutTextMessage).Read(new(ClientConn), bytes.NewReader(data[1:]))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if text := msg.(*ServerCutTextMessage).Text; text != "hello" {
+		t.Fatalf("bad text: %q", text)
+	}
+}
+
+func TestServerCutTextMessage_Latin1Conversion(t *testing.T) {
+	data := []byte{0, 0, 0, 0, 0, 0, 6, 'c', 0xe9, '\r', '\n', 0xff, '!'}
+	msg, err := new(ServerCutTextMessage).Read(new(ClientConn), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if text := msg.(*ServerCutTextMessage).Text; text != "cé\nÿ!" {
+		t.Fatalf("bad text: %q", text)
+	}
+}
+
+func TestClientConn_CutText(t *testing.T) {
+	cases := []struct {
+		policy   CutTextPolicy
+		text     string
+		expected string
+	}{
+		{CutTextError, "café\r\nok", "caf\xe9\nok"},
+		{CutTextError, "日本", ""},
+		{CutTextReplace, "a€b", "a?b"},
+		{CutTextStrip, "a€b", "ab"},
+	}
+
+	for _, tc := range cases {
+		conn, server := newTestClient(t, &ClientConfig{CutTextPolicy: tc.policy}, 8, 8)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- conn.CutText(tc.text)
+		}()
+
+		if tc.expected == "" {
+			if err := <-errCh; err == nil {
+				t.Fatalf("expected error for %q", tc.text)
+			}
+			conn.Close()
+			continue
+		}
+
+		actual := make([]byte, 8+len(tc.expected))
+		if _, err := io.ReadFull(server, actual); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if err := <-errCh; err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		header := []byte{6, 0, 0, 0, 0, 0, 0, byte(len(tc.expected))}
+		if !bytes.Equal(actual, append(header, tc.expected...)) {
+			t.Fatalf("bad message for %q: %q", tc.text, actual)
+		}
+
+		conn.Close()
+	}
+}
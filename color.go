@@ -1,6 +1,59 @@
 package vnc
 
+import (
+	"image/color"
+)
+
 // Color represents a single color in a color map.
+//
+// Colors decoded by RawEncoding for a true color pixel format have the
+// channel ranges of the pixel format, from 0 to RedMax, GreenMax and
+// BlueMax, while colors of the color map have 16 bits per channel. Use
+// RGBA64 or NRGBA to convert either to a standard color.
 type Color struct {
 	R, G, B uint16
 }
+
+// RGBA implements color.Color, treating the channels as 16-bit values,
+// as in the color map. Colors with other channel ranges must be converted
+// with RGBA64 first.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	return uint32(c.R), uint32(c.G), uint32(c.B), 0xffff
+}
+
+// RGBA64 converts a color decoded for the given pixel format to a
+// color.RGBA64.
+func (c Color) RGBA64(pf *PixelFormat) color.RGBA64 {
+	if !pf.TrueColor {
+		return color.RGBA64{c.R, c.G, c.B, 0xffff}
+	}
+
+	scale := func(v, max uint16) uint16 {
+		if max == 0 {
+			return 0
+		}
+		return uint16((uint32(v)*0xffff + uint32(max)/2) / uint32(max))
+	}
+
+	return color.RGBA64{
+		scale(c.R, pf.RedMax),
+		scale(c.G, pf.GreenMax),
+		scale(c.B, pf.BlueMax),
+		0xffff,
+	}
+}
+
+// NRGBA converts a color decoded for the given pixel format to a
+// color.NRGBA, with 8 bits per channel.
+func (c Color) NRGBA(pf *PixelFormat) color.NRGBA {
+	if !pf.TrueColor {
+		return color.NRGBA{uint8(c.R >> 8), uint8(c.G >> 8), uint8(c.B >> 8), 0xff}
+	}
+
+	return color.NRGBA{
+		scaleChannel(c.R, pf.RedMax),
+		scaleChannel(c.G, pf.GreenMax),
+		scaleChannel(c.B, pf.BlueMax),
+		0xff,
+	}
+}
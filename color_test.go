@@ -0,0 +1,75 @@
+package vnc
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestColor_Impl(t *testing.T) {
+	var raw interface{}
+	raw = Color{}
+	if _, ok := raw.(color.Color); !ok {
+		t.Fatal("Color must implement color.Color")
+	}
+
+	r, g, b, a := Color{0x1234, 0, 0xffff}.RGBA()
+	if r != 0x1234 || g != 0 || b != 0xffff || a != 0xffff {
+		t.Fatalf("bad RGBA: %x %x %x %x", r, g, b, a)
+	}
+}
+
+func TestColor_Convert(t *testing.T) {
+	cases := []struct {
+		color  Color
+		pf     PixelFormat
+		rgba64 color.RGBA64
+		nrgba  color.NRGBA
+	}{
+		{
+			Color{255, 128, 0},
+			PixelFormatRGB888,
+			color.RGBA64{0xffff, 0x8080, 0, 0xffff},
+			color.NRGBA{0xff, 0x80, 0, 0xff},
+		},
+		{
+			Color{31, 32, 1},
+			PixelFormatRGB565,
+			color.RGBA64{0xffff, 0x8208, 0x0842, 0xffff},
+			color.NRGBA{0xff, 0x82, 0x08, 0xff},
+		},
+		{
+			Color{0xffff, 0x8000, 0x00ff},
+			PixelFormatColorMap8,
+			color.RGBA64{0xffff, 0x8000, 0x00ff, 0xffff},
+			color.NRGBA{0xff, 0x80, 0, 0xff},
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := tc.color.RGBA64(&tc.pf); actual != tc.rgba64 {
+			t.Fatalf("bad RGBA64 for %v: %v", tc.color, actual)
+		}
+
+		if actual := tc.color.NRGBA(&tc.pf); actual != tc.nrgba {
+			t.Fatalf("bad NRGBA for %v: %v", tc.color, actual)
+		}
+	}
+}
+
+func TestRawEncoding_Image(t *testing.T) {
+	enc := &RawEncoding{Colors: []Color{{31, 0, 0}, {0, 63, 0}, {0, 0, 31}, {0, 0, 0}}}
+	img := enc.Image(&Rectangle{X: 1, Y: 2, Width: 2, Height: 2}, &PixelFormatRGB565)
+
+	if img.Rect != image.Rect(1, 2, 3, 4) {
+		t.Fatalf("bad bounds: %s", img.Rect)
+	}
+
+	if c := img.NRGBAAt(2, 2); c != (color.NRGBA{0, 0xff, 0, 0xff}) {
+		t.Fatalf("bad color: %v", c)
+	}
+
+	if c := img.NRGBAAt(1, 3); c != (color.NRGBA{0, 0, 0xff, 0xff}) {
+		t.Fatalf("bad color: %v", c)
+	}
+}
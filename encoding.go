@@ -2,6 +2,7 @@ package vnc
 
 import (
 	"encoding/binary"
+	"image"
 	"io"
 )
 
@@ -28,9 +29,33 @@ func (*RawEncoding) Type() int32 {
 	return 0
 }
 
+// Image returns the colors of a rectangle as an image, converted for the
+// given pixel format. Its bounds are the rectangle's position in the
+// framebuffer.
+func (e *RawEncoding) Image(rect *Rectangle, pf *PixelFormat) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(
+		int(rect.X), int(rect.Y),
+		int(rect.X)+int(rect.Width), int(rect.Y)+int(rect.Height)))
+
+	width := int(rect.Width)
+	for i, col := range e.Colors {
+		img.SetNRGBA(int(rect.X)+i%width, int(rect.Y)+i/width, col.NRGBA(pf))
+	}
+
+	return img
+}
+
 func (*RawEncoding) Read(c *ClientConn, rect *Rectangle, r io.Reader) (Encoding, error) {
-	bytesPerPixel := c.PixelFormat.BPP / 8
-	pixelBytes := make([]uint8, bytesPerPixel)
+	bytesPerPixel, err := rawBytesPerPixel(&c.PixelFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := readFullBuffer(r, int(rect.Width)*int(rect.Height)*bytesPerPixel)
+	if err != nil {
+		return nil, err
+	}
+	defer putReadBuffer(buf)
 
 	var byteOrder binary.ByteOrder = binary.LittleEndian
 	if c.PixelFormat.BigEndian {
@@ -39,31 +64,84 @@ func (*RawEncoding) Read(c *ClientConn, rect *Rectangle, r io.Reader) (Encoding,
 
 	colors := make([]Color, int(rect.Height)*int(rect.Width))
 
-	for y := uint16(0); y < rect.Height; y++ {
-		for x := uint16(0); x < rect.Width; x++ {
-			if _, err := io.ReadFull(r, pixelBytes); err != nil {
-				return nil, err
-			}
-
-			var rawPixel uint32
-			if c.PixelFormat.BPP == 8 {
-				rawPixel = uint32(pixelBytes[0])
-			} else if c.PixelFormat.BPP == 16 {
-				rawPixel = uint32(byteOrder.Uint16(pixelBytes))
-			} else if c.PixelFormat.BPP == 32 {
-				rawPixel = byteOrder.Uint32(pixelBytes)
-			}
-
-			color := &colors[int(y)*int(rect.Width)+int(x)]
-			if c.PixelFormat.TrueColor {
-				color.R = uint16((rawPixel >> c.PixelFormat.RedShift) & uint32(c.PixelFormat.RedMax))
-				color.G = uint16((rawPixel >> c.PixelFormat.GreenShift) & uint32(c.PixelFormat.GreenMax))
-				color.B = uint16((rawPixel >> c.PixelFormat.BlueShift) & uint32(c.PixelFormat.BlueMax))
-			} else {
-				*color = c.ColorMap[rawPixel]
-			}
+	data := *buf
+	for i := range colors {
+		pixelBytes := data[i*bytesPerPixel : (i+1)*bytesPerPixel]
+
+		var rawPixel uint32
+		if c.PixelFormat.BPP == 8 {
+			rawPixel = uint32(pixelBytes[0])
+		} else if c.PixelFormat.BPP == 16 {
+			rawPixel = uint32(byteOrder.Uint16(pixelBytes))
+		} else if c.PixelFormat.BPP == 32 {
+			rawPixel = byteOrder.Uint32(pixelBytes)
+		}
+
+		color := &colors[i]
+		if c.PixelFormat.TrueColor {
+			color.R = uint16((rawPixel >> c.PixelFormat.RedShift) & uint32(c.PixelFormat.RedMax))
+			color.G = uint16((rawPixel >> c.PixelFormat.GreenShift) & uint32(c.PixelFormat.GreenMax))
+			color.B = uint16((rawPixel >> c.PixelFormat.BlueShift) & uint32(c.PixelFormat.BlueMax))
+		} else {
+			*color = c.ColorMap[rawPixel]
 		}
 	}
 
 	return &RawEncoding{colors}, nil
 }
+
+// DesktopSizePseudoEncoding is sent by the server when the size of the
+// framebuffer changes. The new size is the width and height of the
+// rectangle, which carries no pixel data. Reading it updates the size
+// of the framebuffer on the connection.
+//
+// See RFC 6143 Section 7.8.2
+type DesktopSizePseudoEncoding struct{}
+
+func (*DesktopSizePseudoEncoding) Type() int32 {
+	return -223
+}
+
+func (*DesktopSizePseudoEncoding) Read(c *ClientConn, rect *Rectangle, r io.Reader) (Encoding, error) {
+	if err := c.checkFrameBufferSize(rect.Width, rect.Height); err != nil {
+		return nil, err
+	}
+
+	c.sizeLock.Lock()
+	c.FrameBufferWidth = rect.Width
+	c.FrameBufferHeight = rect.Height
+	c.sizeLock.Unlock()
+
+	return &DesktopSizePseudoEncoding{}, nil
+}
+
+// ContinuousUpdatesPseudoEncoding declares that the client supports the
+// EnableContinuousUpdates message. The server confirms support by sending
+// an EndOfContinuousUpdatesMessage, after which ClientConn's
+// EnableContinuousUpdates can be used.
+//
+// This encoding is never sent in a rectangle.
+type ContinuousUpdatesPseudoEncoding struct{}
+
+func (*ContinuousUpdatesPseudoEncoding) Type() int32 {
+	return -313
+}
+
+func (e *ContinuousUpdatesPseudoEncoding) Read(*ClientConn, *Rectangle, io.Reader) (Encoding, error) {
+	return e, nil
+}
+
+// FencePseudoEncoding declares that the client supports Fence messages.
+// The server confirms support by sending a FenceMessage, after which
+// ClientConn's Fence and Sync can be used.
+//
+// This encoding is never sent in a rectangle.
+type FencePseudoEncoding struct{}
+
+func (*FencePseudoEncoding) Type() int32 {
+	return -312
+}
+
+func (e *FencePseudoEncoding) Read(*ClientConn, *Rectangle, io.Reader) (Encoding, error) {
+	return e, nil
+}
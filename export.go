@@ -0,0 +1,224 @@
+package vnc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"image/gif"
+	"io"
+	"math"
+	"time"
+)
+
+// A FrameWriter writes framebuffer snapshots, taken at a fixed frame rate,
+// as an animation or video. Frames that are identical to the previous
+// frame are merged into it. The output has the size of the first frame,
+// and later frames are drawn at the top left, cropped or padded with
+// black, so that a capture goes on when the desktop is resized.
+type FrameWriter interface {
+	// WriteFrame adds a frame, which is shown for one frame interval
+	// unless the frames after it are identical.
+	WriteFrame(img image.Image) error
+
+	// Close finishes the file. It does not close the underlying writer.
+	Close() error
+}
+
+// errWriterClosed is returned when writing frames to a closed FrameWriter.
+var errWriterClosed = errors.New("frame writer is closed")
+
+// Capture writes snapshots of the screen to w at the given frame rate,
+// until the context is done or the connection is closed, and then returns
+// nil. It does not close w. If no Refresher is running, one is started
+// until Capture returns.
+//
+// To capture a recording played back with a PlaybackConn, play it in
+// real time, or scale the frame rate by the playback speed.
+func (c *ClientConn) Capture(ctx context.Context, w FrameWriter, fps float64) error {
+	interval, err := frameInterval(fps)
+	if err != nil {
+		return err
+	}
+
+	newScreen := c.trackScreen()
+	stop, err := c.requestUpdates(newScreen)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-c.closed:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+
+		if err := w.WriteFrame(c.Snapshot()); err != nil {
+			return err
+		}
+	}
+}
+
+// frameInterval returns the time between frames at the given frame rate,
+// which must be positive and finite.
+func frameInterval(fps float64) (time.Duration, error) {
+	interval := time.Duration(float64(time.Second) / fps)
+	if !(fps > 0) || math.IsInf(fps, 0) || interval <= 0 {
+		return 0, fmt.Errorf("invalid frame rate: %v", fps)
+	}
+
+	return interval, nil
+}
+
+// frameDeduper merges identical consecutive frames, counting how many
+// frame intervals each distinct frame is shown for. Frames are cropped or
+// padded to the size of the first frame.
+type frameDeduper struct {
+	size  image.Rectangle
+	last  *image.RGBA
+	count int
+}
+
+// add adds a frame. If it differs from the previous frame, the previous
+// frame and the number of intervals it is shown for are returned.
+func (d *frameDeduper) add(img image.Image) (*image.RGBA, int) {
+	if d.last == nil {
+		d.size = image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy())
+	}
+
+	frame := image.NewRGBA(d.size)
+	draw.Draw(frame, d.size, img, img.Bounds().Min, draw.Src)
+
+	if d.last != nil && bytes.Equal(d.last.Pix, frame.Pix) {
+		d.count++
+		return nil, 0
+	}
+
+	prev, count := d.last, d.count
+	d.last, d.count = frame, 1
+	return prev, count
+}
+
+// flush returns the last frame, if any.
+func (d *frameDeduper) flush() (*image.RGBA, int) {
+	prev, count := d.last, d.count
+	d.last, d.count = nil, 0
+	return prev, count
+}
+
+// GIFWriter writes frames as an animated GIF that loops forever. Frames
+// are quantized to a palette of their own colors if they have at most 256
+// of them, and are dithered to the Plan 9 palette otherwise. Since a GIF
+// is written in one piece, the frames are kept in memory until Close.
+type GIFWriter struct {
+	w        io.Writer
+	interval time.Duration
+	dedup    frameDeduper
+	anim     gif.GIF
+	closed   bool
+}
+
+// NewGIFWriter returns a GIFWriter that writes frames taken at the given
+// frame rate to w. The frame rate must be positive.
+func NewGIFWriter(w io.Writer, fps float64) (*GIFWriter, error) {
+	interval, err := frameInterval(fps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GIFWriter{
+		w:        w,
+		interval: interval,
+	}, nil
+}
+
+func (g *GIFWriter) WriteFrame(img image.Image) error {
+	if g.closed {
+		return errWriterClosed
+	}
+
+	if prev, count := g.dedup.add(img); prev != nil {
+		g.addFrame(prev, count)
+	}
+
+	return nil
+}
+
+func (g *GIFWriter) Close() error {
+	if g.closed {
+		return errWriterClosed
+	}
+	g.closed = true
+
+	if prev, count := g.dedup.flush(); prev != nil {
+		g.addFrame(prev, count)
+	}
+
+	if len(g.anim.Image) == 0 {
+		return errors.New("no frames to write")
+	}
+
+	return gif.EncodeAll(g.w, &g.anim)
+}
+
+func (g *GIFWriter) addFrame(img *image.RGBA, count int) {
+	// GIF delays are in hundredths of a second
+	delay := int(g.interval * time.Duration(count) / (10 * time.Millisecond))
+	if delay < 2 {
+		// Most viewers show frames with shorter delays for 100ms.
+		delay = 2
+	}
+
+	g.anim.Image = append(g.anim.Image, quantize(img))
+	g.anim.Delay = append(g.anim.Delay, delay)
+}
+
+// quantize converts an image to a paletted image. Images with at most 256
+// colors are converted exactly.
+func quantize(img *image.RGBA) *image.Paletted {
+	b := img.Bounds()
+	colors := make(map[color.RGBA]uint8)
+	var pal color.Palette
+	for i := 0; i < len(img.Pix); i += 4 {
+		c := color.RGBA{img.Pix[i], img.Pix[i+1], img.Pix[i+2], 0xff}
+		if _, ok := colors[c]; ok {
+			continue
+		}
+
+		if len(pal) == 256 {
+			pal = nil
+			break
+		}
+
+		colors[c] = uint8(len(pal))
+		pal = append(pal, c)
+	}
+
+	if pal == nil {
+		result := image.NewPaletted(b, palette.Plan9)
+		draw.FloydSteinberg.Draw(result, b, img, b.Min)
+		return result
+	}
+
+	result := image.NewPaletted(b, pal)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			c.A = 0xff
+			result.SetColorIndex(x, y, colors[c])
+		}
+	}
+
+	return result
+}
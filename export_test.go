@@ -0,0 +1,410 @@
+package vnc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGIFWriter(t *testing.T) {
+	red := solidImage(4, 4, color.RGBA{0xff, 0, 0, 0xff})
+	blue := solidImage(4, 4, color.RGBA{0, 0, 0xff, 0xff})
+
+	var buf bytes.Buffer
+	w, err := NewGIFWriter(&buf, 10)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, img := range []image.Image{red, red, red, blue} {
+		if err := w.WriteFrame(img); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if len(anim.Image) != 2 {
+		t.Fatalf("expected 2 frames, got %d", len(anim.Image))
+	}
+
+	if anim.Delay[0] != 30 || anim.Delay[1] != 10 {
+		t.Fatalf("bad delays: %v", anim.Delay)
+	}
+
+	r, g, b, _ := anim.Image[1].At(2, 2).RGBA()
+	if r != 0 || g != 0 || b != 0xffff {
+		t.Fatalf("bad color: %d %d %d", r, g, b)
+	}
+}
+
+func TestAPNGWriter(t *testing.T) {
+	red := solidImage(4, 4, color.RGBA{0xff, 0, 0, 0xff})
+	blue := solidImage(4, 4, color.RGBA{0, 0, 0xff, 0xff})
+
+	var buf bytes.Buffer
+	w, err := NewAPNGWriter(&buf, 10)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, img := range []image.Image{red, blue, blue} {
+		if err := w.WriteFrame(img); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Decoders without APNG support show the first frame
+	img, err := png.Decode(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if c := color.RGBAModel.Convert(img.At(1, 1)); c != (color.RGBA{0xff, 0, 0, 0xff}) {
+		t.Fatalf("bad default image color: %v", c)
+	}
+
+	// Check the animation chunks
+	b := buf.Bytes()[len(pngSignature):]
+	var types []string
+	var delays []uint16
+	for len(b) > 0 {
+		length := int(binary.BigEndian.Uint32(b))
+		typ, data := string(b[4:8]), b[8:8+length]
+		types = append(types, typ)
+
+		switch typ {
+		case "acTL":
+			if n := binary.BigEndian.Uint32(data); n != 2 {
+				t.Fatalf("bad number of frames: %d", n)
+			}
+		case "fcTL":
+			delays = append(delays, binary.BigEndian.Uint16(data[20:]))
+		}
+
+		b = b[12+length:]
+	}
+
+	expected := []string{"IHDR", "acTL", "fcTL", "IDAT", "fcTL", "fdAT", "IEND"}
+	if len(types) != len(expected) {
+		t.Fatalf("bad chunks: %v", types)
+	}
+	for i := range expected {
+		if types[i] != expected[i] {
+			t.Fatalf("bad chunks: %v", types)
+		}
+	}
+
+	if delays[0] != 100 || delays[1] != 200 {
+		t.Fatalf("bad delays: %v", delays)
+	}
+}
+
+func TestNewFrameWriter_InvalidFPS(t *testing.T) {
+	for _, fps := range []float64{0, -10, math.NaN(), math.Inf(1)} {
+		if _, err := NewGIFWriter(io.Discard, fps); err == nil {
+			t.Fatalf("GIF: error expected for %v", fps)
+		}
+
+		if _, err := NewAPNGWriter(io.Discard, fps); err == nil {
+			t.Fatalf("APNG: error expected for %v", fps)
+		}
+
+		if _, err := NewAVIWriter(nil, fps, nil); err == nil {
+			t.Fatalf("AVI: error expected for %v", fps)
+		}
+	}
+
+	// Frame rates that the AVI header can't hold
+	for _, fps := range []float64{0.0001, 0.0004, 5e6} {
+		if _, err := NewAVIWriter(nil, fps, nil); err == nil {
+			t.Fatalf("AVI: error expected for %v", fps)
+		}
+	}
+
+	if _, err := NewAVIWriter(nil, 0.0005, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestAVIWriter_TooLarge(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "test.avi"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer f.Close()
+
+	w, err := NewAVIWriter(f, 10, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	red := solidImage(16, 8, color.RGBA{0xff, 0, 0, 0xff})
+	if err := w.WriteFrame(red); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Pretend the file is almost 4GB long
+	w.pos = math.MaxUint32 - 100
+	if err := w.WriteFrame(red); err != ErrAVITooLarge {
+		t.Fatalf("expected ErrAVITooLarge, got: %v", err)
+	}
+
+	if len(w.index) != 1 {
+		t.Fatalf("frame was indexed: %d", len(w.index))
+	}
+}
+
+func TestAVIWriter(t *testing.T) {
+	red := solidImage(16, 8, color.RGBA{0xff, 0, 0, 0xff})
+	blue := solidImage(16, 8, color.RGBA{0, 0, 0xff, 0xff})
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "test.avi"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer f.Close()
+
+	w, err := NewAVIWriter(f, 10, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for _, img := range []image.Image{red, red, blue} {
+		if err := w.WriteFrame(img); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	b, err := os.ReadFile(f.Name())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if string(b[0:4]) != "RIFF" || string(b[8:12]) != "AVI " {
+		t.Fatalf("bad header: %q", b[:12])
+	}
+
+	if size := binary.LittleEndian.Uint32(b[4:]); int(size) != len(b)-8 {
+		t.Fatalf("bad RIFF size: %d", size)
+	}
+
+	if n := binary.LittleEndian.Uint32(b[aviTotalFrames:]); n != 3 {
+		t.Fatalf("bad number of frames: %d", n)
+	}
+
+	if w, h := binary.LittleEndian.Uint32(b[64:]), binary.LittleEndian.Uint32(b[68:]); w != 16 || h != 8 {
+		t.Fatalf("bad size: %dx%d", w, h)
+	}
+
+	// The index follows the movie list
+	moviSize := binary.LittleEndian.Uint32(b[aviMoviSize:])
+	idx := b[aviMoviFourCC+int(moviSize):]
+	if string(idx[0:4]) != "idx1" {
+		t.Fatalf("bad index: %q", idx[:4])
+	}
+
+	entries := idx[8:]
+	if len(entries) != 3*16 {
+		t.Fatalf("bad index length: %d", len(entries))
+	}
+
+	var colors []color.RGBA
+	for i := 0; i < 3; i++ {
+		entry := entries[i*16:]
+		offset := aviMoviFourCC + int(binary.LittleEndian.Uint32(entry[8:]))
+		length := int(binary.LittleEndian.Uint32(entry[12:]))
+		if string(b[offset:offset+4]) != "00dc" {
+			t.Fatalf("bad chunk at %d: %q", offset, b[offset:offset+4])
+		}
+
+		img, err := jpeg.Decode(bytes.NewReader(b[offset+8 : offset+8+length]))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		colors = append(colors, color.RGBAModel.Convert(img.At(8, 4)).(color.RGBA))
+	}
+
+	if colors[0] != colors[1] || colors[0].R < 0xf0 || colors[2].B < 0xf0 {
+		t.Fatalf("bad frame colors: %v", colors)
+	}
+}
+
+func TestFrameWriters_Resize(t *testing.T) {
+	frames := []image.Image{
+		solidImage(10, 10, color.RGBA{0xff, 0, 0, 0xff}),
+		solidImage(20, 20, color.RGBA{0, 0, 0xff, 0xff}),
+		solidImage(5, 5, color.RGBA{0, 0xff, 0, 0xff}),
+	}
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "test.avi"))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer f.Close()
+
+	var gifBuf, apngBuf bytes.Buffer
+	gifWriter, _ := NewGIFWriter(&gifBuf, 10)
+	apngWriter, _ := NewAPNGWriter(&apngBuf, 10)
+	aviWriter, _ := NewAVIWriter(f, 10, nil)
+
+	for _, w := range []FrameWriter{gifWriter, apngWriter, aviWriter} {
+		for _, img := range frames {
+			if err := w.WriteFrame(img); err != nil {
+				t.Fatalf("err: %s", err)
+			}
+		}
+
+		if err := w.Close(); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+	}
+
+	anim, err := gif.DecodeAll(&gifBuf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(anim.Image) != 3 {
+		t.Fatalf("expected 3 frames, got %d", len(anim.Image))
+	}
+	for i, img := range anim.Image {
+		if img.Rect != image.Rect(0, 0, 10, 10) {
+			t.Fatalf("bad bounds of frame %d: %s", i, img.Rect)
+		}
+	}
+
+	// The last frame is padded with black
+	if c := color.RGBAModel.Convert(anim.Image[2].At(8, 8)); c != (color.RGBA{0, 0, 0, 0xff}) {
+		t.Fatalf("bad padding color: %v", c)
+	}
+
+	// Every APNG frame control chunk has the size of the first frame
+	b := apngBuf.Bytes()[len(pngSignature):]
+	fctl := 0
+	for len(b) > 0 {
+		length := int(binary.BigEndian.Uint32(b))
+		if typ, data := string(b[4:8]), b[8:8+length]; typ == "fcTL" {
+			fctl++
+			if w, h := binary.BigEndian.Uint32(data[4:]), binary.BigEndian.Uint32(data[8:]); w != 10 || h != 10 {
+				t.Fatalf("bad APNG frame size: %dx%d", w, h)
+			}
+		}
+
+		b = b[12+length:]
+	}
+	if fctl != 3 {
+		t.Fatalf("expected 3 APNG frames, got %d", fctl)
+	}
+}
+
+func TestClientConn_CaptureResize(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 8, 8)
+	defer conn.Close()
+
+	// Enable the DesktopSize pseudo-encoding
+	go io.ReadFull(server, make([]byte, 8))
+	if err := conn.SetEncodings([]Encoding{new(DesktopSizePseudoEncoding)}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The desktop grows after the first frames were captured
+	resize := []byte{0, 0, 0, 1, 0, 0, 0, 0, 0, 16, 0, 16, 0xff, 0xff, 0xff, 0x21}
+	go func() {
+		io.ReadFull(server, make([]byte, 10))
+		server.Write(rawUpdate(image.Rect(0, 0, 8, 8), color.RGBA{0xff, 0, 0, 0xff}))
+		time.Sleep(80 * time.Millisecond)
+		serveUpdates(server, resize)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	var buf bytes.Buffer
+	w, err := NewGIFWriter(&buf, 50)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := conn.Capture(ctx, w, 50); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if width, height := conn.FrameBufferSize(); width != 16 || height != 16 {
+		t.Fatalf("desktop not resized: %dx%d", width, height)
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	anim, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for i, img := range anim.Image {
+		if img.Rect != image.Rect(0, 0, 8, 8) {
+			t.Fatalf("bad bounds of frame %d: %s", i, img.Rect)
+		}
+	}
+}
+
+type countingFrameWriter struct {
+	lock   sync.Mutex
+	frames int
+}
+
+func (w *countingFrameWriter) WriteFrame(image.Image) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.frames++
+	return nil
+}
+
+func (w *countingFrameWriter) Close() error { return nil }
+
+func TestClientConn_Capture(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 8, 8)
+	defer conn.Close()
+
+	go serveUpdates(server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	w := new(countingFrameWriter)
+	if err := conn.Capture(ctx, w, 50); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if w.frames < 5 || w.frames > 10 {
+		t.Fatalf("bad number of frames: %d", w.frames)
+	}
+}
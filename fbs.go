@@ -0,0 +1,300 @@
+package vnc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// fbsHeader starts every FBS file.
+const fbsHeader = "FBS 001.000\n"
+
+// RecordingConn wraps a net.Conn and records the data read from it, which
+// is the byte stream sent by the server, in the FBS 001.000 format used
+// by rfbproxy and vncplay. Pass it to Client in place of the wrapped
+// connection to record a session.
+//
+// An FBS file is the header "FBS 001.000\n" followed by blocks of data.
+// Each block is the data length as a 32-bit big endian integer, the data
+// padded with zeros to a multiple of 4 bytes, and the time in
+// milliseconds since the start of the recording as a 32-bit big endian
+// integer.
+type RecordingConn struct {
+	net.Conn
+
+	lock  sync.Mutex
+	w     io.Writer
+	start time.Time
+	err   error
+}
+
+// NewRecordingConn returns a RecordingConn that records the data read
+// from c to w. The FBS header is written immediately. Closing the
+// RecordingConn closes c, but not w.
+func NewRecordingConn(c net.Conn, w io.Writer) (*RecordingConn, error) {
+	if _, err := io.WriteString(w, fbsHeader); err != nil {
+		return nil, err
+	}
+
+	return &RecordingConn{
+		Conn:  c,
+		w:     w,
+		start: time.Now(),
+	}, nil
+}
+
+// Read reads data from the wrapped connection and records it. If the
+// recording fails, the error is returned from this and all further
+// reads, so that no session continues unrecorded.
+func (r *RecordingConn) Read(b []byte) (int, error) {
+	r.lock.Lock()
+	err := r.err
+	r.lock.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := r.Conn.Read(b)
+	if n > 0 {
+		if recErr := r.record(b[:n]); recErr != nil {
+			return n, recErr
+		}
+	}
+
+	return n, err
+}
+
+// record writes a single block of data to the recording.
+func (r *RecordingConn) record(data []byte) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.err != nil {
+		return r.err
+	}
+
+	timestamp := uint32(time.Since(r.start) / time.Millisecond)
+	if r.err = writeFBSBlock(r.w, data, timestamp); r.err != nil {
+		return r.err
+	}
+
+	return nil
+}
+
+// writeFBSBlock writes a single block of an FBS file.
+func writeFBSBlock(w io.Writer, data []byte, timestamp uint32) error {
+	padded := (len(data) + 3) &^ 3
+	block := make([]byte, 4+padded+4)
+	binary.BigEndian.PutUint32(block, uint32(len(data)))
+	copy(block[4:], data)
+	binary.BigEndian.PutUint32(block[4+padded:], timestamp)
+
+	_, err := w.Write(block)
+	return err
+}
+
+// PlaybackConfig configures the pacing of a PlaybackConn.
+type PlaybackConfig struct {
+	// Speed is the playback speed relative to real time, so 1 plays the
+	// recording in real time and 2 plays it twice as fast. If this is
+	// zero, the recording is played as fast as possible.
+	Speed float64
+
+	// Start is the time in the recording from which playback is paced.
+	// Data recorded before it is delivered as fast as possible.
+	Start time.Duration
+}
+
+// PlaybackConn plays back an FBS recording as a net.Conn, so that Client
+// can connect to a recording and emit the same ServerMessage values as
+// during the recorded session. Data written to it is discarded.
+//
+// The ClientConfig should offer the authentication method used in the
+// recorded session. Its credentials don't matter, since the server's
+// response is replayed regardless.
+type PlaybackConn struct {
+	r   io.Reader
+	cfg PlaybackConfig
+
+	lock       sync.Mutex
+	seekTo     time.Duration
+	position   time.Duration
+	pacedSince time.Time
+	pacedFrom  time.Duration
+
+	block   []byte
+	closeCh chan struct{}
+	closed  sync.Once
+}
+
+// NewPlaybackConn returns a PlaybackConn that plays back the FBS recording
+// read from r. The FBS header is read immediately. cfg may be nil, in which
+// case the recording is played as fast as possible.
+func NewPlaybackConn(r io.Reader, cfg *PlaybackConfig) (*PlaybackConn, error) {
+	if cfg == nil {
+		cfg = new(PlaybackConfig)
+	}
+
+	var header [len(fbsHeader)]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+
+	if string(header[:]) != fbsHeader {
+		return nil, fmt.Errorf("not an FBS 001.000 recording: %q", header[:])
+	}
+
+	return &PlaybackConn{
+		r:       r,
+		cfg:     *cfg,
+		seekTo:  cfg.Start,
+		closeCh: make(chan struct{}),
+	}, nil
+}
+
+// Read reads the recorded server data. Each block of the recording is
+// delivered once its time has come. At the end of the recording, io.EOF
+// is returned.
+func (p *PlaybackConn) Read(b []byte) (int, error) {
+	select {
+	case <-p.closeCh:
+		return 0, ErrClosed
+	default:
+	}
+
+	for len(p.block) == 0 {
+		data, timestamp, err := readFBSBlock(p.r)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := p.wait(timestamp); err != nil {
+			return 0, err
+		}
+
+		p.block = data
+	}
+
+	n := copy(b, p.block)
+	p.block = p.block[n:]
+	return n, nil
+}
+
+// wait blocks until the block with the given timestamp is due.
+func (p *PlaybackConn) wait(timestamp time.Duration) error {
+	p.lock.Lock()
+	p.position = timestamp
+	if p.cfg.Speed <= 0 || timestamp < p.seekTo {
+		p.lock.Unlock()
+		return nil
+	}
+
+	if p.pacedSince.IsZero() {
+		p.pacedSince = time.Now()
+		p.pacedFrom = timestamp
+	}
+
+	due := p.pacedSince.Add(time.Duration(float64(timestamp-p.pacedFrom) / p.cfg.Speed))
+	p.lock.Unlock()
+
+	select {
+	case <-time.After(time.Until(due)):
+		return nil
+	case <-p.closeCh:
+		return ErrClosed
+	}
+}
+
+// Seek skips ahead to the given time in the recording. Data up to that
+// time is delivered as fast as possible, and pacing continues from there.
+// Since the RFB byte stream can't be decoded from the middle, seeking
+// backwards requires playing the recording again from the start with a
+// new PlaybackConn and Start set.
+func (p *PlaybackConn) Seek(t time.Duration) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if t > p.seekTo {
+		p.seekTo = t
+		p.pacedSince = time.Time{}
+	}
+}
+
+// Position returns the time in the recording of the data last read.
+func (p *PlaybackConn) Position() time.Duration {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	return p.position
+}
+
+// Write discards the data, since the recording can't respond to it.
+func (p *PlaybackConn) Write(b []byte) (int, error) {
+	select {
+	case <-p.closeCh:
+		return 0, ErrClosed
+	default:
+		return len(b), nil
+	}
+}
+
+// Close stops the playback. It does not close the underlying reader.
+func (p *PlaybackConn) Close() error {
+	p.closed.Do(func() {
+		close(p.closeCh)
+	})
+
+	return nil
+}
+
+func (p *PlaybackConn) LocalAddr() net.Addr  { return fbsAddr{} }
+func (p *PlaybackConn) RemoteAddr() net.Addr { return fbsAddr{} }
+
+// Deadlines are not supported by PlaybackConn, and are ignored.
+func (p *PlaybackConn) SetDeadline(t time.Time) error      { return nil }
+func (p *PlaybackConn) SetReadDeadline(t time.Time) error  { return nil }
+func (p *PlaybackConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// fbsAddr is the address of both ends of a PlaybackConn.
+type fbsAddr struct{}
+
+func (fbsAddr) Network() string { return "fbs" }
+func (fbsAddr) String() string  { return "recording" }
+
+// readFBSBlock reads a single block of an FBS file.
+func readFBSBlock(r io.Reader) ([]byte, time.Duration, error) {
+	var length uint32
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, 0, err
+	}
+
+	// The buffer grows as the data arrives, so that a corrupt length
+	// doesn't allocate more than the rest of the file. It is kept by the
+	// caller, so it isn't given back to readBuffers.
+	padded := (int64(length) + 3) &^ 3
+	buf, err := readFullBuffer(r, int(padded))
+	if err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+	data := *buf
+
+	var timestamp uint32
+	if err := binary.Read(r, binary.BigEndian, &timestamp); err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+
+	return data[:length], time.Duration(timestamp) * time.Millisecond, nil
+}
+
+// unexpectedEOF turns io.EOF into io.ErrUnexpectedEOF, for errors in the
+// middle of a block.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
@@ -0,0 +1,215 @@
+package vnc
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"net"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestRecordingConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+
+	var recording bytes.Buffer
+	rc, err := NewRecordingConn(client, &recording)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer rc.Close()
+
+	go func() {
+		server.Write([]byte("hello"))
+		server.Write([]byte("worlds!!"))
+	}()
+
+	data := make([]byte, 13)
+	if _, err := io.ReadFull(rc, data); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if string(data) != "helloworlds!!" {
+		t.Fatalf("bad data: %q", data)
+	}
+
+	expected := []byte("FBS 001.000\n" +
+		"\x00\x00\x00\x05hello\x00\x00\x00" + "\x00\x00\x00\x00" +
+		"\x00\x00\x00\x08worlds!!" + "\x00\x00\x00\x00")
+
+	actual := recording.Bytes()
+	if len(actual) != len(expected) {
+		t.Fatalf("bad recording: %q", actual)
+	}
+
+	// Ignore the timestamps, which are very close to zero
+	for _, i := range []int{12 + 12, 12 + 16 + 12} {
+		copy(actual[i:i+4], []byte{0, 0, 0, 0})
+	}
+
+	if !bytes.Equal(actual, expected) {
+		t.Fatalf("bad recording: %q", actual)
+	}
+}
+
+type failingWriter struct {
+	n int
+}
+
+func (w *failingWriter) Write(b []byte) (int, error) {
+	if w.n == 0 {
+		return 0, errors.New("disk full")
+	}
+
+	w.n--
+	return len(b), nil
+}
+
+func TestRecordingConn_WriteError(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+
+	rc, err := NewRecordingConn(client, &failingWriter{n: 1})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer rc.Close()
+
+	go server.Write([]byte("hello"))
+
+	if _, err := rc.Read(make([]byte, 5)); err == nil || err.Error() != "disk full" {
+		t.Fatalf("expected write error, got: %v", err)
+	}
+
+	if _, err := rc.Read(make([]byte, 5)); err == nil || err.Error() != "disk full" {
+		t.Fatalf("expected write error, got: %v", err)
+	}
+}
+
+func TestPlaybackConn(t *testing.T) {
+	// Record a session with a handshake and a single update
+	client, server := net.Pipe()
+	var recording bytes.Buffer
+	rc, err := NewRecordingConn(client, &recording)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	go func() {
+		serveTestHandshake(server, 8, 8)
+		server.Write(rawUpdate(image.Rect(1, 2, 3, 4), color.RGBA{0xff, 0, 0, 0xff}))
+		server.Close()
+	}()
+
+	msgCh := make(chan ServerMessage, 1)
+	conn, err := Client(rc, &ClientConfig{ServerMessageCh: msgCh})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	<-msgCh
+	<-conn.closed
+
+	// Play it back
+	pc, err := NewPlaybackConn(&recording, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	conn, err = Client(pc, &ClientConfig{ServerMessageCh: msgCh})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer conn.Close()
+
+	if conn.FrameBufferWidth != 8 || conn.DesktopName != "test" {
+		t.Fatalf("bad server init: %d %q", conn.FrameBufferWidth, conn.DesktopName)
+	}
+
+	update, ok := (<-msgCh).(*FramebufferUpdateMessage)
+	if !ok || len(update.Rectangles) != 1 {
+		t.Fatalf("bad update: %#v", update)
+	}
+
+	rect := update.Rectangles[0]
+	colors := rect.Enc.(*RawEncoding).Colors
+	if rect.X != 1 || rect.Y != 2 || len(colors) != 4 || colors[0] != (Color{0xff, 0, 0}) {
+		t.Fatalf("bad rectangle: %#v", rect)
+	}
+}
+
+func TestPlaybackConn_Pacing(t *testing.T) {
+	var recording bytes.Buffer
+	recording.WriteString(fbsHeader)
+	writeFBSBlock(&recording, []byte("a"), 0)
+	writeFBSBlock(&recording, []byte("b"), 100)
+	writeFBSBlock(&recording, []byte("c"), 200)
+	writeFBSBlock(&recording, []byte("d"), 10000)
+
+	pc, err := NewPlaybackConn(&recording, &PlaybackConfig{Speed: 2, Start: 100 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	start := time.Now()
+	b := make([]byte, 1)
+	for _, expected := range "abc" {
+		if _, err := pc.Read(b); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if rune(b[0]) != expected {
+			t.Fatalf("bad data: %q", b)
+		}
+	}
+
+	// Up to 100ms is skipped, and the 100ms after that take 50ms
+	if d := time.Since(start); d < 40*time.Millisecond || d > time.Second {
+		t.Fatalf("bad playback time: %s", d)
+	}
+
+	if pos := pc.Position(); pos != 200*time.Millisecond {
+		t.Fatalf("bad position: %s", pos)
+	}
+
+	pc.Seek(10 * time.Second)
+	start = time.Now()
+	if _, err := pc.Read(b); err != nil || b[0] != 'd' {
+		t.Fatalf("bad read: %q %v", b, err)
+	}
+
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("seek didn't skip ahead: %s", d)
+	}
+
+	if _, err := pc.Read(b); err != io.EOF {
+		t.Fatalf("expected EOF, got: %v", err)
+	}
+}
+
+func TestNewPlaybackConn_BadHeader(t *testing.T) {
+	if _, err := NewPlaybackConn(bytes.NewBufferString("RFB 003.008\n"), nil); err == nil {
+		t.Fatal("error expected")
+	}
+}
+
+func TestReadFBSBlock_BadLength(t *testing.T) {
+	// A block claiming to be 4GB long in a short file
+	data := []byte{0xff, 0xff, 0xff, 0xff, 'R', 'F', 'B'}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, _, err := readFBSBlock(bytes.NewReader(data))
+	runtime.ReadMemStats(&after)
+
+	if err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected unexpected EOF, got: %v", err)
+	}
+
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+		t.Fatalf("allocated %d bytes", allocated)
+	}
+}
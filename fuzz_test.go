@@ -0,0 +1,202 @@
+package vnc
+
+import (
+	"bytes"
+	"image"
+	"io"
+	"net"
+	"runtime"
+	"testing"
+)
+
+// The seed corpus in testdata/fuzz is built by hand from the message
+// layouts of RFC 6143 and its extensions, rather than captured from real
+// servers. Messages captured from real sessions, such as those of FBS
+// recordings, are welcome as additional seeds.
+
+// fuzzConn is a connection to a server that sends the fuzzed data, and
+// discards everything the client sends.
+type fuzzConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *fuzzConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+func (c *fuzzConn) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (c *fuzzConn) Close() error {
+	return nil
+}
+
+// fuzzClient returns a client for parsing the fuzzed data, with a small
+// framebuffer.
+func fuzzClient(data []byte) *ClientConn {
+	return &ClientConn{
+		c:                 &fuzzConn{r: bytes.NewReader(data)},
+		config:            &ClientConfig{MaxCutTextLength: 1 << 10},
+		FrameBufferWidth:  64,
+		FrameBufferHeight: 64,
+		PixelFormat:       PixelFormatRGB888,
+		closed:            make(chan struct{}),
+	}
+}
+
+// checkAllocs fails if parsing the fuzzed data with f allocates much
+// more memory than the data itself could describe.
+func checkAllocs(t *testing.T, data []byte, f func()) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	f()
+	runtime.ReadMemStats(&after)
+
+	limit := 4<<20 + 64*uint64(len(data))
+	if allocated := after.TotalAlloc - before.TotalAlloc; allocated > limit {
+		t.Fatalf("allocated %d bytes for %d bytes of data", allocated, len(data))
+	}
+}
+
+func FuzzFramebufferUpdateMessage(f *testing.F) {
+	formats := []PixelFormat{
+		PixelFormatRGB888,
+		PixelFormatBGR888,
+		PixelFormatRGB565,
+		PixelFormatRGB555,
+		PixelFormatBGR233,
+		PixelFormatColorMap8,
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if len(data) == 0 {
+			return
+		}
+
+		// The first byte picks the pixel format and raw decoder
+		c := fuzzClient(data[1:])
+		c.PixelFormat = formats[int(data[0]&0x7f)%len(formats)]
+
+		var raw Encoding = new(RawImageEncoding)
+		if data[0]&0x80 != 0 {
+			raw = new(RawEncoding)
+		}
+
+		c.Encs = []Encoding{
+			raw,
+			new(DesktopSizePseudoEncoding),
+			new(ExtendedMouseButtonsPseudoEncoding),
+			new(QEMUExtendedKeyEventPseudoEncoding),
+		}
+
+		checkAllocs(t, data, func() {
+			msg, err := new(FramebufferUpdateMessage).Read(c, bytes.NewReader(data[1:]))
+			if err != nil {
+				return
+			}
+
+			for _, rect := range msg.(*FramebufferUpdateMessage).Rectangles {
+				bounds := image.Rect(
+					int(rect.X), int(rect.Y),
+					int(rect.X)+int(rect.Width), int(rect.Y)+int(rect.Height))
+
+				switch enc := rect.Enc.(type) {
+				case *RawImageEncoding:
+					if enc.Image.Rect != bounds {
+						t.Fatalf("bad image bounds: %s != %s", enc.Image.Rect, bounds)
+					}
+				case *RawEncoding:
+					if len(enc.Colors) != bounds.Dx()*bounds.Dy() {
+						t.Fatalf("bad number of colors: %d for %s", len(enc.Colors), bounds)
+					}
+				}
+			}
+		})
+	})
+}
+
+func FuzzSetColorMapEntriesMessage(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		c := fuzzClient(data)
+
+		checkAllocs(t, data, func() {
+			msg, err := new(SetColorMapEntriesMessage).Read(c, bytes.NewReader(data))
+			if err != nil {
+				return
+			}
+
+			entries := msg.(*SetColorMapEntriesMessage)
+			for i, col := range entries.Colors {
+				if c.ColorMap[int(entries.FirstColor)+i] != col {
+					t.Fatalf("color %d not set", int(entries.FirstColor)+i)
+				}
+			}
+		})
+	})
+}
+
+func FuzzServerCutTextMessage(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		c := fuzzClient(data)
+
+		checkAllocs(t, data, func() {
+			msg, err := new(ServerCutTextMessage).Read(c, bytes.NewReader(data))
+			if err != nil {
+				return
+			}
+
+			// Latin-1 takes at most 2 bytes per character in UTF-8
+			if text := msg.(*ServerCutTextMessage).Text; len(text) > 2<<10 {
+				t.Fatalf("text too long: %d bytes", len(text))
+			}
+		})
+	})
+}
+
+func FuzzReadPixelFormat(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var format PixelFormat
+		if err := readPixelFormat(bytes.NewReader(data), &format); err != nil {
+			return
+		}
+
+		// Validation must not panic, whatever the server sent
+		format.Validate()
+
+		raw, err := writePixelFormat(&format)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		var actual PixelFormat
+		if err := readPixelFormat(bytes.NewReader(raw), &actual); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if actual != format {
+			t.Fatalf("bad round trip: %#v != %#v", actual, format)
+		}
+	})
+}
+
+func FuzzHandshake(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		c := fuzzClient(data)
+		c.config.Auth = []ClientAuth{
+			&PasswordAuth{Password: "password"},
+			new(ClientAuthNone),
+		}
+
+		checkAllocs(t, data, func() {
+			if err := c.handshake(); err != nil {
+				return
+			}
+
+			if len(c.DesktopName) > defaultMaxDesktopNameLength {
+				t.Fatalf("desktop name too long: %d bytes", len(c.DesktopName))
+			}
+		})
+	})
+}
@@ -0,0 +1,124 @@
+package vnc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mitchellh/go-vnc/keysym"
+)
+
+const (
+	// keysymUnicodeOffset is added to a Unicode code point to get its
+	// keysym, for code points outside of Latin-1.
+	keysymUnicodeOffset = 0x01000000
+)
+
+// usShiftedSymbols are the symbols that need shift to be held on a
+// US keyboard layout.
+const usShiftedSymbols = `~!@#$%^&*()_+{}|:"<>?`
+
+// TypeTextOptions configures how TypeText sends keystrokes.
+type TypeTextOptions struct {
+	// Delay is the time to wait after each typed character.
+	Delay time.Duration
+}
+
+// TypeText types the string s by sending a key press and release for each
+// character. Shift is held for uppercase letters and for symbols that need
+// it on a US keyboard layout. Newlines, tabs, backspaces and escapes are
+// sent as the matching function keys, and "\r\n" is a single Return.
+// opts may be nil.
+//
+// If the context is cancelled, typing stops after the current character
+// and the context's error is returned. No key is left pressed.
+func (c *ClientConn) TypeText(ctx context.Context, s string, opts *TypeTextOptions) error {
+	if opts == nil {
+		opts = new(TypeTextOptions)
+	}
+
+	var prev rune
+	for _, r := range s {
+		if r == '\n' && prev == '\r' {
+			prev = r
+			continue
+		}
+		prev = r
+
+		sym, shift, err := RuneKeysym(r)
+		if err != nil {
+			return err
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := c.typeKey(sym, shift); err != nil {
+			return err
+		}
+
+		if opts.Delay > 0 {
+			select {
+			case <-time.After(opts.Delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	return nil
+}
+
+// typeKey presses and releases a single key, holding shift if requested.
+// Shift is released even if sending the key fails.
+func (c *ClientConn) typeKey(sym uint32, shift bool) (err error) {
+	if shift {
+		if err := c.KeyEvent(keysym.Shift_L, true); err != nil {
+			return err
+		}
+
+		defer func() {
+			if releaseErr := c.KeyEvent(keysym.Shift_L, false); err == nil {
+				err = releaseErr
+			}
+		}()
+	}
+
+	if err := c.KeyEvent(sym, true); err != nil {
+		return err
+	}
+
+	return c.KeyEvent(sym, false)
+}
+
+// RuneKeysym returns the X11 keysym for typing the rune r, and whether
+// shift must be held while typing it on a US keyboard layout. Runes
+// outside of Latin-1 map into the Unicode keysym range.
+func RuneKeysym(r rune) (uint32, bool, error) {
+	switch r {
+	case '\n', '\r':
+		return keysym.Return, false, nil
+	case '\t':
+		return keysym.Tab, false, nil
+	case '\b':
+		return keysym.BackSpace, false, nil
+	case 0x1b:
+		return keysym.Escape, false, nil
+	case 0x7f:
+		return keysym.Delete, false, nil
+	}
+
+	if unicode.IsControl(r) || r > unicode.MaxRune || r == unicode.ReplacementChar {
+		return 0, false, fmt.Errorf("no keysym for character %U", r)
+	}
+
+	shift := unicode.IsUpper(r) || strings.ContainsRune(usShiftedSymbols, r)
+	if r <= unicode.MaxLatin1 {
+		return uint32(r), shift, nil
+	}
+
+	return keysymUnicodeOffset + uint32(r), shift, nil
+}
@@ -0,0 +1,152 @@
+package vnc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"testing"
+)
+
+func TestRuneKeysym(t *testing.T) {
+	tests := []struct {
+		r      rune
+		keysym uint32
+		shift  bool
+		isErr  bool
+	}{
+		{'a', 0x61, false, false},
+		{'A', 0x41, true, false},
+		{'1', 0x31, false, false},
+		{'!', 0x21, true, false},
+		{'/', 0x2f, false, false},
+		{'?', 0x3f, true, false},
+		{' ', 0x20, false, false},
+		{'\n', 0xff0d, false, false},
+		{'\t', 0xff09, false, false},
+		{'é', 0xe9, false, false},
+		{'É', 0xc9, true, false},
+		{'€', 0x010020ac, false, false},
+		{'ж', 0x01000436, false, false},
+		{0x01, 0, false, true},
+	}
+
+	for _, tt := range tests {
+		keysym, shift, err := RuneKeysym(tt.r)
+		if err != nil && !tt.isErr {
+			t.Fatalf("RuneKeysym(%q) unexpected error %v", tt.r, err)
+		}
+		if err == nil && tt.isErr {
+			t.Fatalf("RuneKeysym(%q) expected error", tt.r)
+		}
+		if keysym != tt.keysym {
+			t.Errorf("RuneKeysym(%q) keysym = %#x, want %#x", tt.r, keysym, tt.keysym)
+		}
+		if shift != tt.shift {
+			t.Errorf("RuneKeysym(%q) shift = %v, want %v", tt.r, shift, tt.shift)
+		}
+	}
+}
+
+func TestClientConn_TypeText(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- conn.TypeText(context.Background(), "aB", nil)
+	}()
+
+	type event struct {
+		keysym uint32
+		down   bool
+	}
+
+	expected := []event{
+		{0x61, true},
+		{0x61, false},
+		{0xffe1, true},
+		{0x42, true},
+		{0x42, false},
+		{0xffe1, false},
+	}
+
+	for i, e := range expected {
+		var msg [8]byte
+		if _, err := io.ReadFull(server, msg[:]); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		actual := event{binary.BigEndian.Uint32(msg[4:]), msg[1] == 1}
+		if msg[0] != 4 || actual != e {
+			t.Fatalf("event %d: got %v, want %v", i, actual, e)
+		}
+	}
+
+	if err := <-errCh; err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := conn.TypeText(context.Background(), "\x00", nil); err == nil {
+		t.Fatal("error expected")
+	}
+}
+
+func TestClientConn_TypeTextCRLF(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- conn.TypeText(context.Background(), "a\r\nb", nil)
+	}()
+
+	expected := []uint32{0x61, 0x61, 0xff0d, 0xff0d, 0x62, 0x62}
+	for i, sym := range expected {
+		var msg [8]byte
+		if _, err := io.ReadFull(server, msg[:]); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if actual := binary.BigEndian.Uint32(msg[4:]); actual != sym {
+			t.Fatalf("event %d: got %#x, want %#x", i, actual, sym)
+		}
+	}
+
+	if err := <-errCh; err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+// failingConn records the messages written to it, and fails the write
+// with the given index.
+type failingConn struct {
+	net.Conn
+	fail   int
+	writes [][]byte
+}
+
+func (c *failingConn) Write(b []byte) (int, error) {
+	c.writes = append(c.writes, append([]byte(nil), b...))
+	if len(c.writes)-1 == c.fail {
+		return 0, errors.New("write failed")
+	}
+
+	return len(b), nil
+}
+
+func TestClientConn_typeKeyReleasesShift(t *testing.T) {
+	// Pressing the key fails after shift was pressed
+	fc := &failingConn{fail: 1}
+	conn := &ClientConn{c: fc}
+
+	if err := conn.typeKey(0x42, true); err == nil {
+		t.Fatal("error expected")
+	}
+
+	last := fc.writes[len(fc.writes)-1]
+	if len(fc.writes) != 3 || binary.BigEndian.Uint32(last[4:]) != 0xffe1 || last[1] != 0 {
+		t.Fatalf("shift not released: %v", fc.writes)
+	}
+}
@@ -0,0 +1,181 @@
+//go:build ignore
+// +build ignore
+
+// This program generates keysymdef.go from the X11 keysym headers.
+//
+// Usage:
+//   go run gen.go [-include /usr/include/X11]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+type keysym struct {
+	name       string
+	value      string
+	comment    string
+	deprecated bool
+}
+
+var (
+	defineRe  = regexp.MustCompile(`^#define (XK_|XF86XK_)([a-zA-Z_0-9]+)\s+(0x[0-9a-fA-F]+)\s*(?:/\*\s*(.*?)\s*\*/)?`)
+	sectionRe = regexp.MustCompile(`^#ifdef XK_([A-Z_0-9]+)`)
+)
+
+func main() {
+	include := flag.String("include", "/usr/include/X11", "directory containing the X11 headers")
+	flag.Parse()
+
+	var syms []keysym
+	var sections []string
+	for _, header := range []string{"keysymdef.h", "XF86keysym.h"} {
+		s, err := parse(filepath.Join(*include, header), &sections)
+		if err != nil {
+			log.Fatal(err)
+		}
+		syms = append(syms, s...)
+	}
+
+	names := make(map[string]bool)
+	for _, s := range syms {
+		names[s.name] = true
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintln(&buf, "// Code generated by gen.go from keysymdef.h and XF86keysym.h; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package keysym")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// All X11 keysyms.")
+	fmt.Fprintln(&buf, "const (")
+	section := ""
+	seen := make(map[string]string)
+	for _, s := range syms {
+		if s.section() != section {
+			if section != "" {
+				fmt.Fprintln(&buf)
+			}
+			section = s.section()
+			fmt.Fprintf(&buf, "// %s\n", section)
+		}
+
+		ident := goName(s.name, names)
+		if prev, ok := seen[ident]; ok {
+			log.Fatalf("%s and %s both map to %s", prev, s.name, ident)
+		}
+		seen[ident] = s.name
+
+		fmt.Fprintf(&buf, "%s = %s", ident, strings.ToLower(s.value))
+		if s.comment != "" {
+			fmt.Fprintf(&buf, " // %s", s.comment)
+		}
+		fmt.Fprintln(&buf)
+	}
+	fmt.Fprintln(&buf, ")")
+	fmt.Fprintln(&buf)
+
+	fmt.Fprintln(&buf, "// keysyms lists all keysyms by their X11 name. For keysyms with")
+	fmt.Fprintln(&buf, "// multiple names, the preferred name comes first.")
+	fmt.Fprintln(&buf, "var keysyms = []struct {")
+	fmt.Fprintln(&buf, "name string")
+	fmt.Fprintln(&buf, "sym uint32")
+	fmt.Fprintln(&buf, "deprecated bool")
+	fmt.Fprintln(&buf, "}{")
+	for _, s := range syms {
+		fmt.Fprintf(&buf, "{%q, %s, %v},\n", s.name, goName(s.name, names), s.deprecated)
+	}
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile("keysymdef.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (s keysym) section() string {
+	if strings.HasPrefix(s.name, "XF86") {
+		return "XFree86 vendor specific keysyms, including media keys"
+	}
+
+	return "Keysyms from keysymdef.h"
+}
+
+func parse(path string, sections *[]string) ([]keysym, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var result []keysym
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		m := defineRe.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+
+		name := m[2]
+		if m[1] == "XF86XK_" {
+			name = "XF86" + name
+		}
+
+		comment := m[4]
+		deprecated := strings.Contains(comment, "deprecated")
+		// Legacy keysyms have their Unicode mapping in parentheses
+		if strings.HasPrefix(comment, "(") && strings.HasSuffix(comment, ")") {
+			comment = strings.TrimSpace(comment[1 : len(comment)-1])
+		}
+		if comment == "deprecated" {
+			comment = "Deprecated"
+		}
+
+		result = append(result, keysym{
+			name:       name,
+			value:      m[3],
+			comment:    comment,
+			deprecated: deprecated,
+		})
+	}
+
+	return result, scanner.Err()
+}
+
+// goName returns the Go identifier for an X11 keysym name. Names that
+// start with a lowercase letter are capitalized, and prefixed with
+// "Small" if that would collide with another name, as with "a" and "A".
+// The IBM 3270 keysyms, whose names start with a digit, are prefixed
+// with "IBM".
+func goName(name string, names map[string]bool) string {
+	first := rune(name[0])
+	if unicode.IsDigit(first) {
+		return "IBM" + name
+	}
+
+	if !unicode.IsLower(first) {
+		return name
+	}
+
+	upper := string(unicode.ToUpper(first)) + name[1:]
+	if names[upper] {
+		return "Small" + upper
+	}
+
+	return upper
+}
@@ -0,0 +1,102 @@
+// Package keysym contains the X11 keysym values used to identify keys in
+// VNC KeyEvent messages, along with lookups between keysyms and their
+// X11 names.
+//
+// The constants are named after the X11 names without the "XK_" prefix.
+// Names that start with a lowercase letter are capitalized, and prefixed
+// with "Small" if that collides with another name, so "a" is SmallA and
+// "A" is A. The IBM 3270 keysyms are prefixed with "IBM".
+//
+// References:
+//
+//	[KEYSYMS]: https://gitlab.freedesktop.org/xorg/proto/xorgproto/-/blob/master/include/X11/keysymdef.h
+package keysym
+
+//go:generate go run gen.go
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// unicodeOffset is added to a Unicode code point to get its keysym.
+const unicodeOffset = 0x01000000
+
+var (
+	byName = make(map[string]uint32)
+	bySym  = make(map[uint32]string)
+)
+
+func init() {
+	for _, k := range keysyms {
+		byName[k.name] = k.sym
+	}
+
+	// Prefer names that aren't deprecated, then the first name defined.
+	for _, deprecated := range []bool{false, true} {
+		for _, k := range keysyms {
+			if k.deprecated != deprecated {
+				continue
+			}
+
+			if _, ok := bySym[k.sym]; !ok {
+				bySym[k.sym] = k.name
+			}
+		}
+	}
+}
+
+// Lookup returns the keysym with the given X11 name, such as "Return",
+// "F1" or "a". Names are case sensitive. An optional "XK_" prefix is
+// ignored. Like XStringToKeysym, Lookup also accepts Unicode keysyms
+// in the form "U20AC" and raw values in the form "0xff0d".
+func Lookup(name string) (uint32, bool) {
+	name = strings.TrimPrefix(name, "XK_")
+
+	if sym, ok := byName[name]; ok {
+		return sym, true
+	}
+
+	if len(name) > 1 && name[0] == 'U' {
+		r, err := strconv.ParseUint(name[1:], 16, 32)
+		if err == nil && r <= 0x10ffff {
+			if r < 0x100 {
+				return uint32(r), true
+			}
+
+			return unicodeOffset + uint32(r), true
+		}
+	}
+
+	if strings.HasPrefix(name, "0x") {
+		sym, err := strconv.ParseUint(name[2:], 16, 32)
+		if err == nil {
+			return uint32(sym), true
+		}
+	}
+
+	return 0, false
+}
+
+// Name returns the X11 name of the keysym. Keysyms in the Unicode range
+// that have no name are returned in the form "U20AC". Since Lookup maps
+// names like "U0041" to the Latin-1 keysyms, the Unicode keysyms of
+// Latin-1 characters are returned in the form "0x01000041" instead, so
+// that Lookup returns the same keysym. If the keysym is unknown, false
+// is returned.
+func Name(sym uint32) (string, bool) {
+	if name, ok := bySym[sym]; ok {
+		return name, true
+	}
+
+	if sym >= unicodeOffset && sym <= unicodeOffset+0x10ffff {
+		if sym < unicodeOffset+0x100 {
+			return fmt.Sprintf("0x%08x", sym), true
+		}
+
+		return fmt.Sprintf("U%04X", sym-unicodeOffset), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,92 @@
+package keysym
+
+import (
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name string
+		sym  uint32
+		ok   bool
+	}{
+		{"Return", Return, true},
+		{"XK_Return", Return, true},
+		{"a", SmallA, true},
+		{"A", A, true},
+		{"Shift_L", Shift_L, true},
+		{"KP_Enter", KP_Enter, true},
+		{"F12", F12, true},
+		{"XF86AudioPlay", XF86AudioPlay, true},
+		{"U20AC", 0x010020ac, true},
+		{"U00E9", 0xe9, true},
+		{"0xff0d", 0xff0d, true},
+		{"return", 0, false},
+		{"NotAKey", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		sym, ok := Lookup(tt.name)
+		if ok != tt.ok || sym != tt.sym {
+			t.Errorf("Lookup(%q) = %#x, %v, want %#x, %v", tt.name, sym, ok, tt.sym, tt.ok)
+		}
+	}
+}
+
+func TestName(t *testing.T) {
+	tests := []struct {
+		sym  uint32
+		name string
+		ok   bool
+	}{
+		{Return, "Return", true},
+		{SmallA, "a", true},
+		{A, "A", true},
+		{Henkan_Mode, "Henkan_Mode", true},
+		{ETH, "ETH", true},
+		{XF86AudioPlay, "XF86AudioPlay", true},
+		{EuroSign, "EuroSign", true},
+		{0x010020ac, "U20AC", true},
+		{0x01010000, "U10000", true},
+		{0x01000041, "0x01000041", true},
+		{0x00ffffff - 1, "", false},
+	}
+
+	for _, tt := range tests {
+		name, ok := Name(tt.sym)
+		if ok != tt.ok || name != tt.name {
+			t.Errorf("Name(%#x) = %q, %v, want %q, %v", tt.sym, name, ok, tt.name, tt.ok)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, k := range keysyms {
+		sym, ok := Lookup(k.name)
+		if !ok || sym != k.sym {
+			t.Errorf("Lookup(%q) = %#x, %v", k.name, sym, ok)
+		}
+
+		name, ok := Name(k.sym)
+		if !ok {
+			t.Errorf("Name(%#x) not found", k.sym)
+		}
+
+		if sym, _ := Lookup(name); sym != k.sym {
+			t.Errorf("Lookup(Name(%#x)) = %#x", k.sym, sym)
+		}
+	}
+
+	// Unnamed keysyms in the Unicode range
+	for _, sym := range []uint32{0x01000020, 0x01000041, 0x010000ff, 0x01000100, 0x010020ac} {
+		name, ok := Name(sym)
+		if !ok {
+			t.Errorf("Name(%#x) not found", sym)
+		}
+
+		if actual, _ := Lookup(name); actual != sym {
+			t.Errorf("Lookup(Name(%#x)) = %#x", sym, actual)
+		}
+	}
+}
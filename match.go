@@ -0,0 +1,157 @@
+package vnc
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// ImageTolerance configures how closely the screen must match a
+// reference image.
+type ImageTolerance struct {
+	// Color is the maximum difference of each color channel, from 0 to
+	// 255, for a pixel to match.
+	Color uint8
+
+	// Threshold is the fraction of pixels, from 0 to 1, that may differ
+	// for the image to match.
+	Threshold float64
+}
+
+// toRGBA converts an image to RGBA with its bounds starting at (0, 0).
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Rect.Min == (image.Point{}) {
+		return rgba
+	}
+
+	b := img.Bounds()
+	result := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(result, result.Bounds(), img, b.Min, draw.Src)
+	return result
+}
+
+// opaquePixels returns the number of pixels of the reference image that
+// are compared. Fully transparent pixels match anything.
+func opaquePixels(ref *image.RGBA) int {
+	n := 0
+	for i := 3; i < len(ref.Pix); i += 4 {
+		if ref.Pix[i] != 0 {
+			n++
+		}
+	}
+
+	return n
+}
+
+// pixelMatches returns whether the pixel of img at offset i matches the
+// pixel of ref at offset j.
+func pixelMatches(img, ref []uint8, i, j int, tol uint8) bool {
+	for k := 0; k < 3; k++ {
+		d := int(img[i+k]) - int(ref[j+k])
+		if d < 0 {
+			d = -d
+		}
+
+		if d > int(tol) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// countDiff counts the pixels that differ between ref and img with ref
+// placed at p. It stops counting once the count exceeds limit.
+func countDiff(img, ref *image.RGBA, p image.Point, tol uint8, limit int) int {
+	w, h := ref.Rect.Dx(), ref.Rect.Dy()
+	n := 0
+	for y := 0; y < h; y++ {
+		i := img.PixOffset(p.X, p.Y+y)
+		j := ref.PixOffset(0, y)
+		for x := 0; x < w; x, i, j = x+1, i+4, j+4 {
+			if ref.Pix[j+3] == 0 || pixelMatches(img.Pix, ref.Pix, i, j, tol) {
+				continue
+			}
+
+			n++
+			if n > limit {
+				return n
+			}
+		}
+	}
+
+	return n
+}
+
+// bestMatch searches img for the position at which ref differs in the
+// fewest pixels. It stops at the first position that matches within the
+// tolerance. The mismatch is the fraction of pixels that differ at the
+// returned position.
+func bestMatch(img, ref *image.RGBA, tol ImageTolerance) (image.Point, float64, bool) {
+	total := opaquePixels(ref)
+	allowed := int(tol.Threshold * float64(total))
+
+	w, h := ref.Rect.Dx(), ref.Rect.Dy()
+	bounds := img.Rect
+	if bounds.Dx() < w || bounds.Dy() < h {
+		return bounds.Min, 1, false
+	}
+
+	best := bounds.Min
+	bestDiff := total + 1
+	for y := bounds.Min.Y; y <= bounds.Max.Y-h; y++ {
+		for x := bounds.Min.X; x <= bounds.Max.X-w; x++ {
+			p := image.Pt(x, y)
+			diff := countDiff(img, ref, p, tol.Color, bestDiff-1)
+			if diff >= bestDiff {
+				continue
+			}
+
+			best, bestDiff = p, diff
+			if diff <= allowed {
+				return best, mismatch(diff, total), true
+			}
+		}
+	}
+
+	return best, mismatch(bestDiff, total), false
+}
+
+func mismatch(diff, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(diff) / float64(total)
+}
+
+// diffImage renders the differences between ref and img with ref placed
+// at p. Differing pixels are red, and the other pixels are a faded
+// grayscale copy of img.
+func diffImage(img, ref *image.RGBA, p image.Point, tol uint8) *image.RGBA {
+	w, h := ref.Rect.Dx(), ref.Rect.Dy()
+	result := image.NewRGBA(image.Rect(0, 0, w, h))
+	red := color.RGBA{0xff, 0, 0, 0xff}
+
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if !image.Pt(p.X+x, p.Y+y).In(img.Rect) {
+				result.SetRGBA(x, y, red)
+				continue
+			}
+
+			i := img.PixOffset(p.X+x, p.Y+y)
+			j := ref.PixOffset(x, y)
+			if ref.Pix[j+3] != 0 && !pixelMatches(img.Pix, ref.Pix, i, j, tol) {
+				result.SetRGBA(x, y, red)
+				continue
+			}
+
+			gray := color.GrayModel.Convert(img.RGBAAt(p.X+x, p.Y+y)).(color.Gray)
+			faded := 0x80 + gray.Y/2
+			result.SetRGBA(x, y, color.RGBA{faded, faded, faded, 0xff})
+		}
+	}
+
+	return result
+}
@@ -3,6 +3,8 @@ package vnc
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"io"
 )
 
@@ -22,6 +24,168 @@ type PixelFormat struct {
 	BlueShift  uint8
 }
 
+// Predefined pixel formats. The multi-byte formats are little endian.
+var (
+	// PixelFormatRGB888 is 32 bits per pixel, with 8 bits per channel
+	// and red in the most significant of the used bytes.
+	PixelFormatRGB888 = PixelFormat{
+		BPP: 32, Depth: 24, TrueColor: true,
+		RedMax: 255, GreenMax: 255, BlueMax: 255,
+		RedShift: 16, GreenShift: 8, BlueShift: 0,
+	}
+
+	// PixelFormatBGR888 is like PixelFormatRGB888, with blue in the most
+	// significant of the used bytes.
+	PixelFormatBGR888 = PixelFormat{
+		BPP: 32, Depth: 24, TrueColor: true,
+		RedMax: 255, GreenMax: 255, BlueMax: 255,
+		RedShift: 0, GreenShift: 8, BlueShift: 16,
+	}
+
+	// PixelFormatRGB565 is 16 bits per pixel, with 6 bits for green and
+	// 5 bits for red and blue.
+	PixelFormatRGB565 = PixelFormat{
+		BPP: 16, Depth: 16, TrueColor: true,
+		RedMax: 31, GreenMax: 63, BlueMax: 31,
+		RedShift: 11, GreenShift: 5, BlueShift: 0,
+	}
+
+	// PixelFormatRGB555 is 16 bits per pixel, with 5 bits per channel.
+	PixelFormatRGB555 = PixelFormat{
+		BPP: 16, Depth: 15, TrueColor: true,
+		RedMax: 31, GreenMax: 31, BlueMax: 31,
+		RedShift: 10, GreenShift: 5, BlueShift: 0,
+	}
+
+	// PixelFormatBGR233 is 8 bits per pixel, with 3 bits for red and
+	// green and 2 bits for blue, and blue in the most significant bits.
+	PixelFormatBGR233 = PixelFormat{
+		BPP: 8, Depth: 8, TrueColor: true,
+		RedMax: 7, GreenMax: 7, BlueMax: 3,
+		RedShift: 0, GreenShift: 3, BlueShift: 6,
+	}
+
+	// PixelFormatColorMap8 is 8 bits per pixel, which index the color map
+	// set by the server.
+	PixelFormatColorMap8 = PixelFormat{
+		BPP: 8, Depth: 8,
+	}
+)
+
+// Validate checks that the pixel format is consistent: the number of bits
+// per pixel is 8, 16 or 32, the depth fits in the pixel, and for true
+// color, every maximum is one less than a power of two and the channels
+// fit in the depth without overlapping. Color-mapped pixels must be 8
+// bits, since the color map has 256 entries.
+func (pf *PixelFormat) Validate() error {
+	switch pf.BPP {
+	case 8, 16, 32:
+	default:
+		return fmt.Errorf("invalid bits per pixel: %d", pf.BPP)
+	}
+
+	if pf.Depth == 0 || pf.Depth > pf.BPP {
+		return fmt.Errorf("invalid depth %d for %d bits per pixel", pf.Depth, pf.BPP)
+	}
+
+	if !pf.TrueColor {
+		if pf.BPP != 8 {
+			return fmt.Errorf("color-mapped pixels must be 8 bits, not %d", pf.BPP)
+		}
+
+		return nil
+	}
+
+	channels := []struct {
+		name  string
+		max   uint16
+		shift uint8
+	}{
+		{"red", pf.RedMax, pf.RedShift},
+		{"green", pf.GreenMax, pf.GreenShift},
+		{"blue", pf.BlueMax, pf.BlueShift},
+	}
+
+	var used uint32
+	var bits uint8
+	for _, ch := range channels {
+		if ch.max == 0 || ch.max&(ch.max+1) != 0 {
+			return fmt.Errorf("%s maximum is not one less than a power of two: %d", ch.name, ch.max)
+		}
+
+		if ch.shift >= pf.BPP || uint64(ch.max)<<ch.shift >= 1<<pf.BPP {
+			return fmt.Errorf("%s channel doesn't fit in %d bits per pixel", ch.name, pf.BPP)
+		}
+
+		mask := uint32(ch.max) << ch.shift
+		if used&mask != 0 {
+			return fmt.Errorf("%s channel overlaps another channel", ch.name)
+		}
+		used |= mask
+
+		for m := ch.max; m != 0; m >>= 1 {
+			bits++
+		}
+	}
+
+	if bits > pf.Depth {
+		return fmt.Errorf("channels use %d bits, more than the depth of %d", bits, pf.Depth)
+	}
+
+	return nil
+}
+
+// PixelFormatPreference weighs bandwidth against color fidelity when
+// negotiating a pixel format.
+type PixelFormatPreference int
+
+const (
+	// PreferFidelity keeps all the colors of the server, using up to 32
+	// bits per pixel.
+	PreferFidelity PixelFormatPreference = iota
+
+	// PreferBalanced uses up to 16 bits per pixel.
+	PreferBalanced
+
+	// PreferBandwidth uses 8 bits per pixel.
+	PreferBandwidth
+)
+
+// NegotiatePixelFormat picks a pixel format according to the preference,
+// and sets it with SetPixelFormat. If the server's own format is true
+// color and fits in the bits per pixel allowed by the preference, it is
+// kept, since the server then sends its pixels unconverted. Otherwise,
+// PixelFormatRGB888, PixelFormatRGB565 or PixelFormatBGR233 is used for
+// PreferFidelity, PreferBalanced or PreferBandwidth. It returns the pixel
+// format that was set.
+func (c *ClientConn) NegotiatePixelFormat(pref PixelFormatPreference) (PixelFormat, error) {
+	var format PixelFormat
+	switch pref {
+	case PreferFidelity:
+		format = PixelFormatRGB888
+	case PreferBalanced:
+		format = PixelFormatRGB565
+	case PreferBandwidth:
+		format = PixelFormatBGR233
+	default:
+		return PixelFormat{}, errors.New("unknown pixel format preference")
+	}
+
+	c.formatLock.Lock()
+	native := c.PixelFormat
+	c.formatLock.Unlock()
+
+	if native.TrueColor && native.BPP <= format.BPP && native.Validate() == nil {
+		format = native
+	}
+
+	if err := c.SetPixelFormat(&format); err != nil {
+		return PixelFormat{}, err
+	}
+
+	return format, nil
+}
+
 func readPixelFormat(r io.Reader, result *PixelFormat) error {
 	var rawPixelFormat [16]byte
 	if _, err := io.ReadFull(r, rawPixelFormat[:]); err != nil {
@@ -147,5 +311,8 @@ func writePixelFormat(format *PixelFormat) ([]byte, error) {
 		}
 	}
 
-	return buf.Bytes()[0:16], nil
+	// The rest of the structure is padding
+	buf.Write(make([]byte, 16-buf.Len()))
+
+	return buf.Bytes(), nil
 }
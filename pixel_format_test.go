@@ -0,0 +1,127 @@
+package vnc
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"io"
+	"testing"
+)
+
+func TestPixelFormat_Validate(t *testing.T) {
+	presets := []PixelFormat{
+		PixelFormatRGB888,
+		PixelFormatBGR888,
+		PixelFormatRGB565,
+		PixelFormatRGB555,
+		PixelFormatBGR233,
+		PixelFormatColorMap8,
+	}
+
+	for _, pf := range presets {
+		if err := pf.Validate(); err != nil {
+			t.Fatalf("preset %#v invalid: %s", pf, err)
+		}
+	}
+
+	invalid := []PixelFormat{
+		// Bits per pixel
+		{BPP: 24, Depth: 24, TrueColor: true, RedMax: 255, GreenMax: 255, BlueMax: 255, RedShift: 16, GreenShift: 8},
+		// Depth larger than the pixel
+		{BPP: 16, Depth: 24, TrueColor: true, RedMax: 31, GreenMax: 63, BlueMax: 31, RedShift: 11, GreenShift: 5},
+		// Color map with 16 bits
+		{BPP: 16, Depth: 16},
+		// Maximum not a power of two minus one
+		{BPP: 16, Depth: 16, TrueColor: true, RedMax: 30, GreenMax: 63, BlueMax: 31, RedShift: 11, GreenShift: 5},
+		// Channel outside the pixel
+		{BPP: 16, Depth: 16, TrueColor: true, RedMax: 31, GreenMax: 63, BlueMax: 31, RedShift: 12, GreenShift: 5},
+		// Overlapping channels
+		{BPP: 32, Depth: 24, TrueColor: true, RedMax: 255, GreenMax: 255, BlueMax: 255, RedShift: 16, GreenShift: 12},
+		// Channels use more bits than the depth
+		{BPP: 16, Depth: 15, TrueColor: true, RedMax: 31, GreenMax: 63, BlueMax: 31, RedShift: 11, GreenShift: 5},
+	}
+
+	for _, pf := range invalid {
+		if err := pf.Validate(); err == nil {
+			t.Fatalf("expected %#v to be invalid", pf)
+		}
+	}
+}
+
+func TestClientConn_NegotiatePixelFormat(t *testing.T) {
+	cases := []struct {
+		pref     PixelFormatPreference
+		expected PixelFormat
+	}{
+		// The test server's format is RGB888
+		{PreferFidelity, PixelFormatRGB888},
+		{PreferBalanced, PixelFormatRGB565},
+		{PreferBandwidth, PixelFormatBGR233},
+	}
+
+	for _, tc := range cases {
+		conn, server := newTestClient(t, &ClientConfig{}, 8, 8)
+
+		msgCh := make(chan []byte, 1)
+		go func() {
+			msg := make([]byte, 20)
+			io.ReadFull(server, msg)
+			msgCh <- msg
+		}()
+
+		format, err := conn.NegotiatePixelFormat(tc.pref)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if format != tc.expected || conn.PixelFormat != tc.expected {
+			t.Fatalf("bad format for %d: %#v", tc.pref, format)
+		}
+
+		expected, _ := writePixelFormat(&tc.expected)
+		if msg := <-msgCh; msg[0] != 0 || !bytes.Equal(msg[4:], expected) {
+			t.Fatalf("bad message: %v", msg)
+		}
+
+		conn.Close()
+	}
+}
+
+func TestClientConn_SetPixelFormatWhileDecoding(t *testing.T) {
+	msgCh := make(chan ServerMessage)
+	conn, server := newTestClient(t, &ClientConfig{ServerMessageCh: msgCh}, 8, 8)
+	defer conn.Close()
+	conn.Snapshot()
+
+	go io.Copy(io.Discard, server)
+	go func() {
+		for i := 0; i < 100; i++ {
+			server.Write(rawUpdate(image.Rect(0, 0, 8, 8), color.RGBA{uint8(i), 0, 0, 255}))
+		}
+	}()
+
+	// Setting the format the updates are in keeps them decodable, while
+	// the race detector checks that the format isn't changed under the
+	// main loop.
+	for i := 0; i < 100; i++ {
+		if err := conn.SetPixelFormat(&PixelFormatRGB888); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if _, ok := (<-msgCh).(*FramebufferUpdateMessage); !ok {
+			t.Fatal("expected framebuffer update")
+		}
+	}
+}
+
+func TestWritePixelFormat_ColorMap(t *testing.T) {
+	data, err := writePixelFormat(&PixelFormatColorMap8)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []byte{8, 8, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	if !bytes.Equal(data, expected) {
+		t.Fatalf("bad data: %v", data)
+	}
+}
@@ -1,7 +1,13 @@
 package vnc
 
+import (
+	"image"
+	"io"
+	"math"
+)
+
 // ButtonMask represents a mask of pointer presses/releases.
-type ButtonMask uint8
+type ButtonMask uint16
 
 // All available button mask components.
 const (
@@ -13,4 +19,178 @@ const (
 	Button6
 	Button7
 	Button8
+	Button9
+)
+
+// The buttons usually found on the side of a mouse. ButtonForward can only
+// be sent to servers that support the ExtendedMouseButtonsPseudoEncoding.
+const (
+	ButtonBack    = Button8
+	ButtonForward = Button9
 )
+
+// ExtendedMouseButtonsPseudoEncoding declares that the client supports
+// sending the extended button mask in PointerEvent messages. The server
+// confirms support by sending an empty rectangle with this encoding,
+// after which PointerEvent sends Button9 as well.
+type ExtendedMouseButtonsPseudoEncoding struct{}
+
+func (*ExtendedMouseButtonsPseudoEncoding) Type() int32 {
+	return -316
+}
+
+func (e *ExtendedMouseButtonsPseudoEncoding) Read(c *ClientConn, rect *Rectangle, r io.Reader) (Encoding, error) {
+	c.setServerSupports(e.Type())
+	return e, nil
+}
+
+// Pointer returns the position and the pressed buttons of the pointer, as
+// sent in the last PointerEvent.
+func (c *ClientConn) Pointer() (image.Point, ButtonMask) {
+	c.pointerStateLock.Lock()
+	defer c.pointerStateLock.Unlock()
+
+	return image.Pt(int(c.pointerX), int(c.pointerY)), c.buttons
+}
+
+// MoveTo moves the pointer to p, keeping the pressed buttons pressed.
+func (c *ClientConn) MoveTo(p image.Point) error {
+	_, buttons := c.Pointer()
+	x, y := clampPoint(p)
+	return c.PointerEvent(buttons, x, y)
+}
+
+// MoveSmooth moves the pointer from its current position to p in the
+// given number of steps, keeping the pressed buttons pressed. This is
+// useful for applications that track pointer movement, such as hover
+// effects.
+func (c *ClientConn) MoveSmooth(p image.Point, steps int) error {
+	from, _ := c.Pointer()
+	return c.moveSteps(from, p, steps)
+}
+
+// Click moves the pointer to p, then presses and releases the button.
+// Other buttons that are already pressed stay pressed.
+func (c *ClientConn) Click(button ButtonMask, p image.Point) error {
+	if err := c.MoveTo(p); err != nil {
+		return err
+	}
+
+	return c.pressRelease(button)
+}
+
+// DoubleClick moves the pointer to p, then clicks the button twice.
+func (c *ClientConn) DoubleClick(button ButtonMask, p image.Point) error {
+	if err := c.Click(button, p); err != nil {
+		return err
+	}
+
+	return c.pressRelease(button)
+}
+
+// Drag moves the pointer to from, presses the button, moves the pointer
+// to to in the given number of steps, and releases the button.
+func (c *ClientConn) Drag(button ButtonMask, from, to image.Point, steps int) error {
+	if err := c.MoveTo(from); err != nil {
+		return err
+	}
+
+	if err := c.setButtons(button, true); err != nil {
+		return err
+	}
+
+	if err := c.moveSteps(from, to, steps); err != nil {
+		return err
+	}
+
+	return c.setButtons(button, false)
+}
+
+// Scroll scrolls at the current pointer position. Each unit of dy clicks
+// the scroll wheel once, scrolling down for positive values and up for
+// negative values. Likewise, dx scrolls right for positive values and
+// left for negative values.
+func (c *ClientConn) Scroll(dx, dy int) error {
+	scrolls := []struct {
+		n        int
+		neg, pos ButtonMask
+	}{
+		{dy, Button4, Button5},
+		{dx, Button6, Button7},
+	}
+
+	for _, s := range scrolls {
+		button := s.pos
+		n := s.n
+		if n < 0 {
+			button = s.neg
+			n = -n
+		}
+
+		for i := 0; i < n; i++ {
+			if err := c.pressRelease(button); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// pressRelease presses and releases the button at the current pointer
+// position.
+func (c *ClientConn) pressRelease(button ButtonMask) error {
+	if err := c.setButtons(button, true); err != nil {
+		return err
+	}
+
+	return c.setButtons(button, false)
+}
+
+// setButtons presses or releases the buttons at the current pointer
+// position, leaving other buttons as they are.
+func (c *ClientConn) setButtons(buttons ButtonMask, down bool) error {
+	p, mask := c.Pointer()
+	if down {
+		mask |= buttons
+	} else {
+		mask &^= buttons
+	}
+
+	return c.PointerEvent(mask, uint16(p.X), uint16(p.Y))
+}
+
+// moveSteps moves the pointer in a straight line from one point to
+// another in the given number of steps.
+func (c *ClientConn) moveSteps(from, to image.Point, steps int) error {
+	if steps < 1 {
+		steps = 1
+	}
+
+	for i := 1; i <= steps; i++ {
+		p := image.Pt(
+			from.X+(to.X-from.X)*i/steps,
+			from.Y+(to.Y-from.Y)*i/steps)
+		if err := c.MoveTo(p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clampPoint converts a point to pointer coordinates, clamping it to the
+// range that can be sent to the server.
+func clampPoint(p image.Point) (uint16, uint16) {
+	clamp := func(v int) uint16 {
+		if v < 0 {
+			return 0
+		}
+		if v > math.MaxUint16 {
+			return math.MaxUint16
+		}
+		return uint16(v)
+	}
+
+	return clamp(p.X), clamp(p.Y)
+}
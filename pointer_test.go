@@ -0,0 +1,215 @@
+package vnc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+type pointerEvent struct {
+	mask ButtonMask
+	x, y uint16
+}
+
+func readPointerEvents(t *testing.T, c net.Conn, n int) []pointerEvent {
+	result := make([]pointerEvent, n)
+	for i := range result {
+		var msg [6]byte
+		if _, err := io.ReadFull(c, msg[:]); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if msg[0] != 5 {
+			t.Fatalf("bad message type: %d", msg[0])
+		}
+
+		result[i] = pointerEvent{
+			ButtonMask(msg[1]),
+			binary.BigEndian.Uint16(msg[2:]),
+			binary.BigEndian.Uint16(msg[4:]),
+		}
+	}
+
+	return result
+}
+
+func checkPointerEvents(t *testing.T, server net.Conn, f func() error, expected []pointerEvent) {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- f()
+	}()
+
+	actual := readPointerEvents(t, server, len(expected))
+	if err := <-errCh; err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("event %d: got %v, want %v", i, actual[i], expected[i])
+		}
+	}
+}
+
+func TestClientConn_Click(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	checkPointerEvents(t, server, func() error {
+		return conn.DoubleClick(ButtonLeft, image.Pt(10, 20))
+	}, []pointerEvent{
+		{0, 10, 20},
+		{ButtonLeft, 10, 20},
+		{0, 10, 20},
+		{ButtonLeft, 10, 20},
+		{0, 10, 20},
+	})
+
+	// A held button must stay held when clicking another
+	checkPointerEvents(t, server, func() error {
+		return conn.PointerEvent(ButtonRight, 10, 20)
+	}, []pointerEvent{{ButtonRight, 10, 20}})
+
+	checkPointerEvents(t, server, func() error {
+		return conn.Click(ButtonLeft, image.Pt(-5, 70000))
+	}, []pointerEvent{
+		{ButtonRight, 0, 65535},
+		{ButtonRight | ButtonLeft, 0, 65535},
+		{ButtonRight, 0, 65535},
+	})
+
+	p, buttons := conn.Pointer()
+	if p != image.Pt(0, 65535) || buttons != ButtonRight {
+		t.Fatalf("bad pointer state: %v %v", p, buttons)
+	}
+}
+
+func TestClientConn_Drag(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	checkPointerEvents(t, server, func() error {
+		return conn.Drag(ButtonLeft, image.Pt(0, 0), image.Pt(30, 60), 3)
+	}, []pointerEvent{
+		{0, 0, 0},
+		{ButtonLeft, 0, 0},
+		{ButtonLeft, 10, 20},
+		{ButtonLeft, 20, 40},
+		{ButtonLeft, 30, 60},
+		{0, 30, 60},
+	})
+
+	checkPointerEvents(t, server, func() error {
+		return conn.MoveSmooth(image.Pt(10, 60), 2)
+	}, []pointerEvent{
+		{0, 20, 60},
+		{0, 10, 60},
+	})
+}
+
+func TestClientConn_Scroll(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	checkPointerEvents(t, server, func() error {
+		return conn.Scroll(1, -2)
+	}, []pointerEvent{
+		{Button4, 0, 0},
+		{0, 0, 0},
+		{Button4, 0, 0},
+		{0, 0, 0},
+		{Button7, 0, 0},
+		{0, 0, 0},
+	})
+}
+
+func TestClientConn_PointerEventExtended(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	// Without server support, the legacy format is used
+	checkPointerEvents(t, server, func() error {
+		return conn.PointerEvent(ButtonBack|ButtonForward|ButtonLeft, 1, 2)
+	}, []pointerEvent{{ButtonBack | ButtonLeft, 1, 2}})
+
+	go io.ReadFull(server, make([]byte, 8))
+	if err := conn.SetEncodings([]Encoding{new(ExtendedMouseButtonsPseudoEncoding)}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var update bytes.Buffer
+	update.Write([]byte{0, 0, 0, 1})
+	binary.Write(&update, binary.BigEndian, []uint16{0, 0, 0, 0})
+	binary.Write(&update, binary.BigEndian, int32(-316))
+	server.Write(update.Bytes())
+
+	// Wait for the main loop to handle the update
+	for !conn.serverSupports(-316) {
+		time.Sleep(time.Millisecond)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- conn.PointerEvent(ButtonForward|ButtonLeft, 1, 2)
+	}()
+
+	var msg [7]byte
+	if _, err := io.ReadFull(server, msg[:]); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := <-errCh; err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []byte{5, 0x81, 0, 1, 0, 2, 0x02}
+	if !bytes.Equal(msg[:], expected) {
+		t.Fatalf("bad message: %v", msg)
+	}
+
+	// Masks without extended buttons still use the legacy format
+	checkPointerEvents(t, server, func() error {
+		return conn.PointerEvent(ButtonLeft, 1, 2)
+	}, []pointerEvent{{ButtonLeft, 1, 2}})
+}
+
+func TestClientConn_PointerDuringWrite(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 8, 8)
+	defer conn.Close()
+
+	// The event blocks, since the server doesn't read it yet
+	sent := make(chan error, 1)
+	go func() {
+		sent <- conn.PointerEvent(ButtonLeft, 2, 3)
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	// Screen listeners on the main loop read the pointer position
+	done := make(chan image.Point, 1)
+	go func() {
+		p, _ := conn.Pointer()
+		done <- p
+	}()
+
+	select {
+	case p := <-done:
+		if p != (image.Point{}) {
+			t.Fatalf("bad position before the event was sent: %s", p)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Pointer blocked on a pending PointerEvent")
+	}
+
+	io.ReadFull(server, make([]byte, 6))
+	if err := <-sent; err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if p, buttons := conn.Pointer(); p != image.Pt(2, 3) || buttons != ButtonLeft {
+		t.Fatalf("bad pointer state: %s %d", p, buttons)
+	}
+}
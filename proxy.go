@@ -0,0 +1,276 @@
+package vnc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+)
+
+// A ProxyConfig structure is used to configure a Proxy.
+type ProxyConfig struct {
+	// Dial connects to the upstream VNC server. It is called once for
+	// every client that connects to the proxy.
+	Dial func() (net.Conn, error)
+
+	// A slice of ClientAuth methods used to authenticate with the
+	// upstream server. Only the first instance that is suitable by the
+	// server will be used.
+	UpstreamAuth []ClientAuth
+
+	// A slice of ServerAuth methods offered to clients connecting to the
+	// proxy, in order of preference. If this is nil, clients aren't
+	// authenticated.
+	Auth []ServerAuth
+
+	// Record, if set, is called for every session after the client
+	// authenticated, and returns the writer to which the session is
+	// recorded. The writer is closed when the session ends.
+	//
+	// The recording is an FBS file of the data sent to the client, as
+	// written by a RecordingConn, except that the authentication is
+	// replaced with the "none" authentication so that the recording can
+	// be played back with a PlaybackConn.
+	Record func(client net.Conn) (io.WriteCloser, error)
+
+	// ErrorLog is used to log the errors of sessions that failed. If it
+	// is nil, the standard logger is used.
+	ErrorLog *log.Logger
+}
+
+// Proxy is a VNC proxy that sits between clients and a VNC server. It
+// authenticates clients with its own credentials, connects to the server
+// with the configured ones, and then relays all messages in both
+// directions, optionally recording the session.
+type Proxy struct {
+	config *ProxyConfig
+}
+
+// NewProxy returns a Proxy with the given configuration, which must not
+// be modified afterwards.
+func NewProxy(cfg *ProxyConfig) *Proxy {
+	return &Proxy{config: cfg}
+}
+
+// Serve accepts connections from the listener and serves each of them in
+// a new goroutine. It returns when accepting a connection fails.
+func (p *Proxy) Serve(l net.Listener) error {
+	return serveListener(l, p.ServeConn, p.config.ErrorLog)
+}
+
+// ServeConn serves a single client connection, and closes it when the
+// session ends. It returns nil if the session ended because either side
+// closed its connection.
+func (p *Proxy) ServeConn(c net.Conn) error {
+	defer c.Close()
+
+	shared, err := serverHandshake(c, p.config.Auth)
+	if err != nil {
+		return err
+	}
+
+	if p.config.Dial == nil {
+		return errors.New("no dial function configured")
+	}
+
+	upstream, err := p.config.Dial()
+	if err != nil {
+		return err
+	}
+	defer upstream.Close()
+
+	// Use the client handshake to connect, without starting the main
+	// loop, since the messages are relayed as they are.
+	conn := &ClientConn{
+		c: upstream,
+		config: &ClientConfig{
+			Auth:      p.config.UpstreamAuth,
+			Exclusive: !shared,
+		},
+	}
+
+	if err := conn.handshake(); err != nil {
+		return fmt.Errorf("upstream handshake: %s", err)
+	}
+
+	serverInit, err := writeServerInit(conn)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.Write(serverInit); err != nil {
+		return err
+	}
+
+	var src io.Reader = upstream
+	if p.config.Record != nil {
+		w, err := p.config.Record(c)
+		if err != nil {
+			return err
+		}
+		defer w.Close()
+
+		rc, err := NewRecordingConn(upstream, w)
+		if err != nil {
+			return err
+		}
+
+		// What a client using no authentication would have received
+		var handshake bytes.Buffer
+		handshake.WriteString("RFB 003.008\n")
+		handshake.Write([]byte{1, 1, 0, 0, 0, 0})
+		handshake.Write(serverInit)
+		if err := rc.record(handshake.Bytes()); err != nil {
+			return err
+		}
+
+		src = rc
+	}
+
+	return runSession(c, upstream,
+		func() error {
+			_, err := io.Copy(upstream, c)
+			return err
+		},
+		func() error {
+			_, err := io.Copy(c, src)
+			return err
+		})
+}
+
+// runSession runs both directions of a relayed session until either of
+// them ends, and then closes both connections to end the other one as
+// well. It returns the error of the direction that ended first.
+func runSession(c, upstream net.Conn, toServer, toClient func() error) error {
+	errCh := make(chan error, 2)
+	go func() { errCh <- toServer() }()
+	go func() { errCh <- toClient() }()
+
+	err := <-errCh
+	c.Close()
+	upstream.Close()
+	<-errCh
+
+	return err
+}
+
+// serveListener accepts connections from the listener and serves each of
+// them in a new goroutine, logging the errors of failed sessions. It
+// returns when accepting a connection fails.
+func serveListener(l net.Listener, serve func(net.Conn) error, errorLog *log.Logger) error {
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			if err := serve(c); err != nil {
+				logf := log.Printf
+				if errorLog != nil {
+					logf = errorLog.Printf
+				}
+
+				logf("vnc: session from %s: %s", c.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+// serverHandshake performs the server side of the handshake up to and
+// including the ClientInit message, which it returns the shared flag of.
+func serverHandshake(c net.Conn, auths []ServerAuth) (bool, error) {
+	if auths == nil {
+		auths = []ServerAuth{new(ServerAuthNone)}
+	}
+
+	// 7.1.1 ProtocolVersion
+	if _, err := c.Write([]byte("RFB 003.008\n")); err != nil {
+		return false, err
+	}
+
+	var protocolVersion [pvLen]byte
+	if _, err := io.ReadFull(c, protocolVersion[:]); err != nil {
+		return false, err
+	}
+
+	major, minor, err := parseProtocolVersion(protocolVersion[:])
+	if err != nil {
+		return false, err
+	}
+	if major != 3 || minor < 8 {
+		return false, fmt.Errorf("unsupported client version: %d.%d", major, minor)
+	}
+
+	// 7.1.2 Security Handshake
+	securityTypes := make([]uint8, 0, 1+len(auths))
+	securityTypes = append(securityTypes, uint8(len(auths)))
+	for _, auth := range auths {
+		securityTypes = append(securityTypes, auth.SecurityType())
+	}
+
+	if _, err := c.Write(securityTypes); err != nil {
+		return false, err
+	}
+
+	var securityType uint8
+	if err := binary.Read(c, binary.BigEndian, &securityType); err != nil {
+		return false, err
+	}
+
+	var auth ServerAuth
+	for _, curAuth := range auths {
+		if curAuth.SecurityType() == securityType {
+			auth = curAuth
+			break
+		}
+	}
+
+	if auth == nil {
+		err = fmt.Errorf("client chose unsupported security type: %d", securityType)
+	} else {
+		err = auth.Handshake(c)
+	}
+
+	// 7.1.3 SecurityResult
+	if err != nil {
+		var result bytes.Buffer
+		binary.Write(&result, binary.BigEndian, uint32(1))
+		binary.Write(&result, binary.BigEndian, uint32(len(errAuthFailed.Error())))
+		result.WriteString(errAuthFailed.Error())
+		c.Write(result.Bytes())
+		return false, err
+	}
+
+	if _, err := c.Write([]byte{0, 0, 0, 0}); err != nil {
+		return false, err
+	}
+
+	// 7.3.1 ClientInit
+	var sharedFlag uint8
+	if err := binary.Read(c, binary.BigEndian, &sharedFlag); err != nil {
+		return false, err
+	}
+
+	return sharedFlag != 0, nil
+}
+
+// writeServerInit returns the ServerInit message of a connection. (7.3.2)
+func writeServerInit(c *ClientConn) ([]byte, error) {
+	pfBytes, err := writePixelFormat(&c.PixelFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, c.FrameBufferWidth)
+	binary.Write(&buf, binary.BigEndian, c.FrameBufferHeight)
+	buf.Write(pfBytes)
+	binary.Write(&buf, binary.BigEndian, uint32(len(c.DesktopName)))
+	buf.WriteString(c.DesktopName)
+
+	return buf.Bytes(), nil
+}
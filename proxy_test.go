@@ -0,0 +1,137 @@
+package vnc
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+type closingBuffer struct {
+	bytes.Buffer
+	closed chan struct{}
+}
+
+func (b *closingBuffer) Close() error {
+	close(b.closed)
+	return nil
+}
+
+func TestProxy(t *testing.T) {
+	upstream, server := net.Pipe()
+	defer server.Close()
+
+	recording := &closingBuffer{closed: make(chan struct{})}
+	proxy := NewProxy(&ProxyConfig{
+		Dial: func() (net.Conn, error) {
+			go serveTestHandshake(server, 8, 4)
+			return upstream, nil
+		},
+		Auth: []ServerAuth{&ServerPasswordAuth{Password: "secret"}},
+		Record: func(net.Conn) (io.WriteCloser, error) {
+			return recording, nil
+		},
+	})
+
+	client, proxied := net.Pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- proxy.ServeConn(proxied)
+	}()
+
+	msgCh := make(chan ServerMessage, 1)
+	conn, err := Client(client, &ClientConfig{
+		Auth:            []ClientAuth{&PasswordAuth{Password: "secret"}},
+		ServerMessageCh: msgCh,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if conn.FrameBufferWidth != 8 || conn.FrameBufferHeight != 4 || conn.DesktopName != "test" {
+		t.Fatalf("bad server init: %dx%d %q",
+			conn.FrameBufferWidth, conn.FrameBufferHeight, conn.DesktopName)
+	}
+
+	// Messages are relayed in both directions
+	if err := conn.KeyEvent(0x61, true); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	var msg [8]byte
+	if _, err := io.ReadFull(server, msg[:]); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !bytes.Equal(msg[:], []byte{4, 1, 0, 0, 0, 0, 0, 0x61}) {
+		t.Fatalf("bad key event: %v", msg)
+	}
+
+	if _, err := server.Write([]byte{2}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	select {
+	case msg := <-msgCh:
+		if _, ok := msg.(*BellMessage); !ok {
+			t.Fatalf("bad message: %#v", msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for bell")
+	}
+
+	server.Close()
+	if err := <-errCh; err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	<-recording.closed
+	conn.Close()
+
+	// The recording can be played back without authentication
+	playback, err := NewPlaybackConn(&recording.Buffer, nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	playbackCh := make(chan ServerMessage, 1)
+	replayed, err := Client(playback, &ClientConfig{ServerMessageCh: playbackCh})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer replayed.Close()
+
+	if replayed.DesktopName != "test" {
+		t.Fatalf("bad desktop name: %q", replayed.DesktopName)
+	}
+
+	if _, ok := (<-playbackCh).(*BellMessage); !ok {
+		t.Fatal("bell not recorded")
+	}
+}
+
+func TestProxy_BadPassword(t *testing.T) {
+	proxy := NewProxy(&ProxyConfig{
+		Dial: func() (net.Conn, error) {
+			t.Error("dialed upstream for unauthenticated client")
+			return nil, io.EOF
+		},
+		Auth: []ServerAuth{&ServerPasswordAuth{Password: "secret"}},
+	})
+
+	client, proxied := net.Pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- proxy.ServeConn(proxied)
+	}()
+
+	_, err := Client(client, &ClientConfig{
+		Auth: []ClientAuth{&PasswordAuth{Password: "wrong"}},
+	})
+	if err == nil {
+		t.Fatal("expected authentication to fail")
+	}
+
+	if err := <-errCh; err != errAuthFailed {
+		t.Fatalf("bad error: %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+package vnc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/mitchellh/go-vnc/keysym"
+)
+
+// ErrQEMUKeyEventUnsupported is returned by QEMUKeyEvent if the server
+// hasn't confirmed support for QEMU extended key events.
+var ErrQEMUKeyEventUnsupported = errors.New("server does not support QEMU extended key events")
+
+// QEMUExtendedKeyEventPseudoEncoding declares that the client supports
+// QEMU extended key events. The server confirms support by sending an
+// empty rectangle with this encoding, after which ClientConn's
+// QEMUKeyEvent can be used.
+type QEMUExtendedKeyEventPseudoEncoding struct{}
+
+func (*QEMUExtendedKeyEventPseudoEncoding) Type() int32 {
+	return -258
+}
+
+func (e *QEMUExtendedKeyEventPseudoEncoding) Read(c *ClientConn, rect *Rectangle, r io.Reader) (Encoding, error) {
+	c.setServerSupports(e.Type())
+	return e, nil
+}
+
+// QEMUKeyEvent indicates a key press or release, identified by both its
+// keysym and its XT scancode. Unlike KeyEvent, this lets the server
+// translate the key correctly regardless of the keyboard layout of the
+// guest, and send keys that have no keysym. The USScancode function
+// returns the scancodes for a US keyboard layout.
+//
+// XT scancodes with an 0xe0 prefix are encoded with the high bit of the
+// second byte set, so 0xe048 is sent as 0xc8.
+//
+// The QEMUExtendedKeyEventPseudoEncoding must be set with SetEncodings,
+// and the server must have confirmed support, before this can be used.
+func (c *ClientConn) QEMUKeyEvent(keysym, keycode uint32, down bool) error {
+	if !c.serverSupports(new(QEMUExtendedKeyEventPseudoEncoding).Type()) {
+		return ErrQEMUKeyEventUnsupported
+	}
+
+	var buf bytes.Buffer
+	var downFlag uint16 = 0
+	if down {
+		downFlag = 1
+	}
+
+	data := []interface{}{
+		uint8(255),
+		uint8(0),
+		downFlag,
+		keysym,
+		keycode,
+	}
+
+	for _, val := range data {
+		if err := binary.Write(&buf, binary.BigEndian, val); err != nil {
+			return err
+		}
+	}
+
+	return c.write(buf.Bytes()[0:12])
+}
+
+// USScancode returns the XT scancode of the key that produces the keysym
+// on a US keyboard layout, encoded as expected by QEMUKeyEvent. Shifted
+// characters return the scancode of their key, so both "a" and "A"
+// return 0x1e.
+func USScancode(sym uint32) (uint32, bool) {
+	code, ok := usScancodes[sym]
+	return code, ok
+}
+
+// usScancodes maps keysyms to XT scancodes for a US keyboard layout.
+var usScancodes = map[uint32]uint32{
+	keysym.Escape:       0x01,
+	keysym.BackSpace:    0x0e,
+	keysym.Tab:          0x0f,
+	keysym.ISO_Left_Tab: 0x0f,
+	keysym.Return:       0x1c,
+	keysym.Control_L:    0x1d,
+	keysym.Shift_L:      0x2a,
+	keysym.Shift_R:      0x36,
+	keysym.Alt_L:        0x38,
+	keysym.Space:        0x39,
+	keysym.Caps_Lock:    0x3a,
+	keysym.F1:           0x3b,
+	keysym.F2:           0x3c,
+	keysym.F3:           0x3d,
+	keysym.F4:           0x3e,
+	keysym.F5:           0x3f,
+	keysym.F6:           0x40,
+	keysym.F7:           0x41,
+	keysym.F8:           0x42,
+	keysym.F9:           0x43,
+	keysym.F10:          0x44,
+	keysym.Num_Lock:     0x45,
+	keysym.Scroll_Lock:  0x46,
+	keysym.F11:          0x57,
+	keysym.F12:          0x58,
+
+	// Keypad
+	keysym.KP_Multiply:  0x37,
+	keysym.KP_7:         0x47,
+	keysym.KP_Home:      0x47,
+	keysym.KP_8:         0x48,
+	keysym.KP_Up:        0x48,
+	keysym.KP_9:         0x49,
+	keysym.KP_Page_Up:   0x49,
+	keysym.KP_Subtract:  0x4a,
+	keysym.KP_4:         0x4b,
+	keysym.KP_Left:      0x4b,
+	keysym.KP_5:         0x4c,
+	keysym.KP_Begin:     0x4c,
+	keysym.KP_6:         0x4d,
+	keysym.KP_Right:     0x4d,
+	keysym.KP_Add:       0x4e,
+	keysym.KP_1:         0x4f,
+	keysym.KP_End:       0x4f,
+	keysym.KP_2:         0x50,
+	keysym.KP_Down:      0x50,
+	keysym.KP_3:         0x51,
+	keysym.KP_Page_Down: 0x51,
+	keysym.KP_0:         0x52,
+	keysym.KP_Insert:    0x52,
+	keysym.KP_Decimal:   0x53,
+	keysym.KP_Delete:    0x53,
+
+	// Keys with an 0xe0 prefix
+	keysym.KP_Enter:             0x9c,
+	keysym.Control_R:            0x9d,
+	keysym.KP_Divide:            0xb5,
+	keysym.Print:                0xb7,
+	keysym.Alt_R:                0xb8,
+	keysym.ISO_Level3_Shift:     0xb8,
+	keysym.Pause:                0xc6,
+	keysym.Home:                 0xc7,
+	keysym.Up:                   0xc8,
+	keysym.Page_Up:              0xc9,
+	keysym.Left:                 0xcb,
+	keysym.Right:                0xcd,
+	keysym.End:                  0xcf,
+	keysym.Down:                 0xd0,
+	keysym.Page_Down:            0xd1,
+	keysym.Insert:               0xd2,
+	keysym.Delete:               0xd3,
+	keysym.Super_L:              0xdb,
+	keysym.Super_R:              0xdc,
+	keysym.Menu:                 0xdd,
+	keysym.XF86AudioPrev:        0x90,
+	keysym.XF86AudioNext:        0x99,
+	keysym.XF86AudioMute:        0xa0,
+	keysym.XF86AudioPlay:        0xa2,
+	keysym.XF86AudioStop:        0xa4,
+	keysym.XF86AudioLowerVolume: 0xae,
+	keysym.XF86AudioRaiseVolume: 0xb0,
+}
+
+func init() {
+	// The rows of character keys, unshifted and shifted, with the
+	// scancode of the first key in each row.
+	rows := []struct {
+		normal, shifted string
+		first           uint32
+	}{
+		{"1234567890-=", "!@#$%^&*()_+", 0x02},
+		{"qwertyuiop[]", "QWERTYUIOP{}", 0x10},
+		{"asdfghjkl;'`", "ASDFGHJKL:\"~", 0x1e},
+		{"\\zxcvbnm,./", "|ZXCVBNM<>?", 0x2b},
+	}
+
+	// The keysyms of these characters are their code points.
+	for _, row := range rows {
+		for i, r := range row.normal {
+			usScancodes[uint32(r)] = row.first + uint32(i)
+		}
+
+		for i, r := range row.shifted {
+			usScancodes[uint32(r)] = row.first + uint32(i)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package vnc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/go-vnc/keysym"
+)
+
+func TestUSScancode(t *testing.T) {
+	tests := []struct {
+		sym  uint32
+		code uint32
+		ok   bool
+	}{
+		{keysym.SmallA, 0x1e, true},
+		{keysym.A, 0x1e, true},
+		{keysym.Exclam, 0x02, true},
+		{keysym.Backslash, 0x2b, true},
+		{keysym.Question, 0x35, true},
+		{keysym.Return, 0x1c, true},
+		{keysym.KP_Enter, 0x9c, true},
+		{keysym.Up, 0xc8, true},
+		{keysym.F12, 0x58, true},
+		{keysym.EuroSign, 0, false},
+	}
+
+	for _, tt := range tests {
+		code, ok := USScancode(tt.sym)
+		if code != tt.code || ok != tt.ok {
+			t.Errorf("USScancode(%#x) = %#x, %v, want %#x, %v", tt.sym, code, ok, tt.code, tt.ok)
+		}
+	}
+}
+
+func TestClientConn_QEMUKeyEvent(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	if err := conn.QEMUKeyEvent(keysym.SmallA, 0x1e, true); err != ErrQEMUKeyEventUnsupported {
+		t.Fatalf("expected ErrQEMUKeyEventUnsupported, got: %v", err)
+	}
+
+	go io.ReadFull(server, make([]byte, 8))
+	if err := conn.SetEncodings([]Encoding{new(QEMUExtendedKeyEventPseudoEncoding)}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Confirm support with an empty rectangle of the pseudo-encoding
+	var update bytes.Buffer
+	update.Write([]byte{0, 0, 0, 1})
+	binary.Write(&update, binary.BigEndian, []uint16{0, 0, 0, 0})
+	binary.Write(&update, binary.BigEndian, int32(-258))
+	server.Write(update.Bytes())
+
+	errCh := make(chan error, 1)
+	go func() {
+		// The update is handled by the main loop asynchronously, so
+		// retry until it has been seen.
+		for {
+			err := conn.QEMUKeyEvent(keysym.SmallA, 0x1e, true)
+			if err != ErrQEMUKeyEventUnsupported {
+				errCh <- err
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}()
+
+	var msg [12]byte
+	if _, err := io.ReadFull(server, msg[:]); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if err := <-errCh; err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []byte{255, 0, 0, 1, 0, 0, 0, 0x61, 0, 0, 0, 0x1e}
+	if !bytes.Equal(msg[:], expected) {
+		t.Fatalf("bad message: %v", msg)
+	}
+}
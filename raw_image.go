@@ -0,0 +1,173 @@
+package vnc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"io"
+)
+
+// RawImageEncoding is raw pixel data sent by the server, like
+// RawEncoding, but decoded straight into an image. The whole rectangle is
+// read at once, and pixels are converted with lookup tables, which is
+// much faster than decoding them into Colors. Pass it to SetEncodings in
+// place of RawEncoding to use it.
+//
+// See RFC 6143 Section 7.7.1
+type RawImageEncoding struct {
+	// Image holds the pixels of the rectangle. Its bounds are the
+	// rectangle's position in the framebuffer.
+	Image *image.RGBA
+}
+
+func (*RawImageEncoding) Type() int32 {
+	return 0
+}
+
+func (*RawImageEncoding) Read(c *ClientConn, rect *Rectangle, r io.Reader) (Encoding, error) {
+	bytesPerPixel, err := rawBytesPerPixel(&c.PixelFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := readFullBuffer(r, int(rect.Width)*int(rect.Height)*bytesPerPixel)
+	if err != nil {
+		return nil, err
+	}
+	defer putReadBuffer(buf)
+
+	img := image.NewRGBA(image.Rect(
+		int(rect.X), int(rect.Y),
+		int(rect.X)+int(rect.Width), int(rect.Y)+int(rect.Height)))
+	c.pixelConverter().convert(img.Pix, *buf)
+
+	return &RawImageEncoding{img}, nil
+}
+
+// rawBytesPerPixel returns the size of a raw pixel in the given pixel
+// format, or an error if raw pixels of the format can't be decoded.
+// Color-mapped pixels must fit the color map.
+func rawBytesPerPixel(pf *PixelFormat) (int, error) {
+	bytesPerPixel := int(pf.BPP / 8)
+	switch bytesPerPixel {
+	case 1, 2, 4:
+	default:
+		return 0, fmt.Errorf("unsupported bits per pixel: %d", pf.BPP)
+	}
+
+	if !pf.TrueColor && bytesPerPixel != 1 {
+		return 0, fmt.Errorf("unsupported color-mapped bits per pixel: %d", pf.BPP)
+	}
+
+	return bytesPerPixel, nil
+}
+
+// pixelConverter converts pixels of a pixel format to RGBA, with lookup
+// tables from the channel values to 8 bits.
+type pixelConverter struct {
+	format PixelFormat
+
+	// The tables for true color, indexed by channel value
+	red, green, blue []uint8
+
+	// The table for color-mapped pixels, with 4 bytes per index
+	colorMap [256 * 4]uint8
+}
+
+// pixelConverter returns a pixelConverter for the current pixel format
+// and color map. This is called from the main loop.
+func (c *ClientConn) pixelConverter() *pixelConverter {
+	p := c.converter
+	if p == nil || p.format != c.PixelFormat {
+		p = newPixelConverter(c.PixelFormat)
+		c.converter = p
+	}
+
+	if !p.format.TrueColor {
+		// The color map may change at any time, and is small.
+		for i, col := range c.ColorMap {
+			p.colorMap[i*4] = uint8(col.R >> 8)
+			p.colorMap[i*4+1] = uint8(col.G >> 8)
+			p.colorMap[i*4+2] = uint8(col.B >> 8)
+			p.colorMap[i*4+3] = 0xff
+		}
+	}
+
+	return p
+}
+
+func newPixelConverter(format PixelFormat) *pixelConverter {
+	p := &pixelConverter{format: format}
+
+	if format.TrueColor {
+		p.red = channelTable(format.RedMax)
+		p.green = channelTable(format.GreenMax)
+		p.blue = channelTable(format.BlueMax)
+	}
+
+	return p
+}
+
+// channelTable returns the 8-bit values of all values of a channel.
+func channelTable(max uint16) []uint8 {
+	table := make([]uint8, int(max)+1)
+	for v := range table {
+		table[v] = scaleChannel(uint16(v), max)
+	}
+
+	return table
+}
+
+// scaleChannel scales a channel value with the given maximum to 8 bits.
+func scaleChannel(v, max uint16) uint8 {
+	if max == 0 {
+		return 0
+	}
+
+	return uint8((uint32(v)*0xff + uint32(max)/2) / uint32(max))
+}
+
+// convert converts the raw pixels in data to RGBA pixels in dst. The
+// loops are specialized per pixel size and byte order, since this is
+// done for every pixel of every update.
+func (p *pixelConverter) convert(dst, data []byte) {
+	if !p.format.TrueColor {
+		for i, j := 0, 0; i < len(data); i, j = i+1, j+4 {
+			copy(dst[j:j+4], p.colorMap[int(data[i])*4:])
+		}
+		return
+	}
+
+	f := &p.format
+	red, green, blue := p.red, p.green, p.blue
+	redMax, greenMax, blueMax := uint32(f.RedMax), uint32(f.GreenMax), uint32(f.BlueMax)
+	set := func(d []byte, raw uint32) {
+		d[0] = red[(raw>>f.RedShift)&redMax]
+		d[1] = green[(raw>>f.GreenShift)&greenMax]
+		d[2] = blue[(raw>>f.BlueShift)&blueMax]
+		d[3] = 0xff
+	}
+
+	switch {
+	case f.BPP == 8:
+		for i, j := 0, 0; i < len(data); i, j = i+1, j+4 {
+			set(dst[j:j+4], uint32(data[i]))
+		}
+	case f.BPP == 16 && f.BigEndian:
+		for i, j := 0, 0; i+2 <= len(data); i, j = i+2, j+4 {
+			set(dst[j:j+4], uint32(data[i])<<8|uint32(data[i+1]))
+		}
+	case f.BPP == 16:
+		for i, j := 0, 0; i+2 <= len(data); i, j = i+2, j+4 {
+			set(dst[j:j+4], uint32(data[i])|uint32(data[i+1])<<8)
+		}
+	case f.BPP == 32 && f.BigEndian:
+		for i, j := 0, 0; i+4 <= len(data); i, j = i+4, j+4 {
+			set(dst[j:j+4], binary.BigEndian.Uint32(data[i:i+4]))
+		}
+	case f.BPP == 32:
+		for i, j := 0, 0; i+4 <= len(data); i, j = i+4, j+4 {
+			set(dst[j:j+4], binary.LittleEndian.Uint32(data[i:i+4]))
+		}
+	}
+}
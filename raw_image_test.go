@@ -0,0 +1,131 @@
+package vnc
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+)
+
+func TestRawImageEncoding_Read(t *testing.T) {
+	bigEndian565 := PixelFormatRGB565
+	bigEndian565.BigEndian = true
+
+	formats := []PixelFormat{
+		PixelFormatRGB888,
+		PixelFormatBGR888,
+		PixelFormatRGB565,
+		bigEndian565,
+		PixelFormatRGB555,
+		PixelFormatBGR233,
+		PixelFormatColorMap8,
+	}
+
+	rect := &Rectangle{X: 3, Y: 5, Width: 16, Height: 8}
+	rnd := rand.New(rand.NewSource(1))
+
+	for _, pf := range formats {
+		c := &ClientConn{PixelFormat: pf}
+		for i := range c.ColorMap {
+			c.ColorMap[i] = Color{uint16(rnd.Intn(0x10000)), uint16(rnd.Intn(0x10000)), uint16(rnd.Intn(0x10000))}
+		}
+
+		data := make([]byte, int(rect.Width)*int(rect.Height)*int(pf.BPP/8))
+		rnd.Read(data)
+
+		raw, err := new(RawEncoding).Read(c, rect, bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		enc, err := new(RawImageEncoding).Read(c, rect, bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		img := enc.(*RawImageEncoding).Image
+		if img.Rect != image.Rect(3, 5, 19, 13) {
+			t.Fatalf("bad bounds: %s", img.Rect)
+		}
+
+		for i, col := range raw.(*RawEncoding).Colors {
+			x, y := 3+i%16, 5+i/16
+			if actual, expected := img.RGBAAt(x, y), c.rgba(col); actual != expected {
+				t.Fatalf("bad pixel %d,%d for %#v: %v != %v", x, y, pf, actual, expected)
+			}
+		}
+	}
+}
+
+func TestClientConn_updateScreenRawImage(t *testing.T) {
+	c := &ClientConn{
+		FrameBufferWidth:  8,
+		FrameBufferHeight: 8,
+		PixelFormat:       PixelFormatRGB888,
+		Encs:              []Encoding{new(RawImageEncoding)},
+	}
+	c.trackScreen()
+
+	var changed []image.Rectangle
+	c.listenScreen(func(rects []image.Rectangle) {
+		changed = append(changed, rects...)
+	})
+
+	white := color.RGBA{0xff, 0xff, 0xff, 0xff}
+	update := rawUpdate(image.Rect(2, 2, 6, 6), white)
+	msg, err := new(FramebufferUpdateMessage).Read(c, bytes.NewReader(update[1:]))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, ok := msg.(*FramebufferUpdateMessage).Rectangles[0].Enc.(*RawImageEncoding); !ok {
+		t.Fatal("expected rectangle decoded by RawImageEncoding")
+	}
+
+	c.updateScreen(msg)
+
+	if len(changed) != 1 || changed[0] != image.Rect(2, 2, 6, 6) {
+		t.Fatalf("bad changed areas: %v", changed)
+	}
+
+	screen := c.Snapshot()
+	if screen.RGBAAt(2, 2) != white || screen.RGBAAt(6, 6) == white {
+		t.Fatal("bad screen contents")
+	}
+}
+
+func benchmarkRawRead(b *testing.B, enc Encoding, pf PixelFormat) {
+	c := &ClientConn{PixelFormat: pf}
+	rect := &Rectangle{Width: 1920, Height: 1080}
+	data := make([]byte, 1920*1080*int(pf.BPP/8))
+	rand.New(rand.NewSource(1)).Read(data)
+
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	r := bytes.NewReader(data)
+	for i := 0; i < b.N; i++ {
+		r.Reset(data)
+		if _, err := enc.Read(c, rect, r); err != nil {
+			b.Fatalf("err: %s", err)
+		}
+	}
+}
+
+func BenchmarkRawEncoding_Read32(b *testing.B) {
+	benchmarkRawRead(b, new(RawEncoding), PixelFormatRGB888)
+}
+
+func BenchmarkRawImageEncoding_Read32(b *testing.B) {
+	benchmarkRawRead(b, new(RawImageEncoding), PixelFormatRGB888)
+}
+
+func BenchmarkRawEncoding_Read16(b *testing.B) {
+	benchmarkRawRead(b, new(RawEncoding), PixelFormatRGB565)
+}
+
+func BenchmarkRawImageEncoding_Read16(b *testing.B) {
+	benchmarkRawRead(b, new(RawImageEncoding), PixelFormatRGB565)
+}
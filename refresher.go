@@ -0,0 +1,224 @@
+package vnc
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrRefresherRunning is returned by StartRefresher if the connection
+// already has a running Refresher.
+var ErrRefresherRunning = errors.New("a refresher is already running on this connection")
+
+// A RefresherConfig structure is used to configure a Refresher.
+type RefresherConfig struct {
+	// MaxFPS caps the number of framebuffer update requests sent per
+	// second. If this is zero, a new request is sent as soon as the
+	// previous update arrives.
+	MaxFPS float64
+}
+
+// A Refresher keeps framebuffer updates flowing from the server. It sends
+// a new incremental FramebufferUpdateRequest each time an update arrives,
+// so that exactly one request is outstanding at any time.
+//
+// If the DesktopSizePseudoEncoding is enabled with SetEncodings, the
+// requested region follows the size of the framebuffer.
+type Refresher struct {
+	c        *ClientConn
+	interval time.Duration
+	remove   func()
+
+	lock     sync.Mutex
+	width    uint16
+	height   uint16
+	resized  bool
+	resumeCh chan struct{}
+	err      error
+
+	updateCh chan struct{}
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// StartRefresher starts a Refresher on the connection. The first request
+// covers the whole framebuffer and is non-incremental. Only one Refresher
+// can run on a connection at a time.
+func (c *ClientConn) StartRefresher(cfg *RefresherConfig) (*Refresher, error) {
+	if cfg == nil {
+		cfg = new(RefresherConfig)
+	}
+
+	width, height := c.FrameBufferSize()
+	r := &Refresher{
+		c:        c,
+		width:    width,
+		height:   height,
+		updateCh: make(chan struct{}, 1),
+		stopCh:   make(chan struct{}),
+		doneCh:   make(chan struct{}),
+	}
+
+	if cfg.MaxFPS > 0 {
+		r.interval = time.Duration(float64(time.Second) / cfg.MaxFPS)
+	}
+
+	c.refresherLock.Lock()
+	defer c.refresherLock.Unlock()
+	if c.refresher != nil {
+		return nil, ErrRefresherRunning
+	}
+	c.refresher = r
+
+	r.remove = c.listen(r.onMessage)
+	go r.loop()
+
+	return r, nil
+}
+
+// Pause stops the Refresher from sending new requests. A request that is
+// already outstanding is not cancelled.
+func (r *Refresher) Pause() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.resumeCh == nil {
+		r.resumeCh = make(chan struct{})
+	}
+}
+
+// Resume continues sending requests after a call to Pause.
+func (r *Refresher) Resume() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.resumeCh != nil {
+		close(r.resumeCh)
+		r.resumeCh = nil
+	}
+}
+
+// Stop stops the Refresher and waits for it to exit. It returns the error
+// that caused the Refresher to exit early, if any. After Stop returns, a
+// new Refresher can be started on the connection.
+func (r *Refresher) Stop() error {
+	r.stopOnce.Do(func() {
+		close(r.stopCh)
+	})
+	<-r.doneCh
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+// Done returns a channel that is closed when the Refresher exits, either
+// because it was stopped or because the connection was closed.
+func (r *Refresher) Done() <-chan struct{} {
+	return r.doneCh
+}
+
+func (r *Refresher) onMessage(msg ServerMessage) {
+	update, ok := msg.(*FramebufferUpdateMessage)
+	if !ok {
+		return
+	}
+
+	for _, rect := range update.Rectangles {
+		if _, ok := rect.Enc.(*DesktopSizePseudoEncoding); ok {
+			r.lock.Lock()
+			r.width = rect.Width
+			r.height = rect.Height
+			r.resized = true
+			r.lock.Unlock()
+		}
+	}
+
+	select {
+	case r.updateCh <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Refresher) loop() {
+	defer close(r.doneCh)
+	defer func() {
+		r.remove()
+
+		r.c.refresherLock.Lock()
+		if r.c.refresher == r {
+			r.c.refresher = nil
+		}
+		r.c.refresherLock.Unlock()
+	}()
+
+	incremental := false
+	var last time.Time
+	for {
+		if !r.waitResumed() {
+			return
+		}
+
+		if wait := r.interval - time.Since(last); r.interval > 0 && wait > 0 {
+			select {
+			case <-time.After(wait):
+			case <-r.stopCh:
+				return
+			case <-r.c.closed:
+				return
+			}
+
+			// We may have been paused while waiting.
+			if !r.waitResumed() {
+				return
+			}
+		}
+
+		r.lock.Lock()
+		width, height := r.width, r.height
+		if r.resized {
+			incremental = false
+			r.resized = false
+		}
+		r.lock.Unlock()
+
+		last = time.Now()
+		if err := r.c.FramebufferUpdateRequest(incremental, 0, 0, width, height); err != nil {
+			r.lock.Lock()
+			r.err = err
+			r.lock.Unlock()
+			return
+		}
+		incremental = true
+
+		select {
+		case <-r.updateCh:
+		case <-r.stopCh:
+			return
+		case <-r.c.closed:
+			return
+		}
+	}
+}
+
+// waitResumed blocks while the Refresher is paused. It returns false if
+// the Refresher should exit instead.
+func (r *Refresher) waitResumed() bool {
+	r.lock.Lock()
+	resumeCh := r.resumeCh
+	r.lock.Unlock()
+
+	if resumeCh == nil {
+		return true
+	}
+
+	select {
+	case <-resumeCh:
+		return true
+	case <-r.stopCh:
+		return false
+	case <-r.c.closed:
+		return false
+	}
+}
@@ -0,0 +1,114 @@
+package vnc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+)
+
+func readUpdateRequest(t *testing.T, c net.Conn) (bool, uint16, uint16) {
+	var msg [10]byte
+	c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := io.ReadFull(c, msg[:]); err != nil {
+		t.Fatalf("error reading request: %s", err)
+	}
+
+	if msg[0] != 3 {
+		t.Fatalf("bad message type: %d", msg[0])
+	}
+
+	return msg[1] == 1, binary.BigEndian.Uint16(msg[6:]), binary.BigEndian.Uint16(msg[8:])
+}
+
+func TestRefresher(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	// Enable the DesktopSize pseudo-encoding
+	go io.ReadFull(server, make([]byte, 8))
+	if err := conn.SetEncodings([]Encoding{new(DesktopSizePseudoEncoding)}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	r, err := conn.StartRefresher(nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := conn.StartRefresher(nil); err != ErrRefresherRunning {
+		t.Fatalf("expected ErrRefresherRunning, got: %v", err)
+	}
+
+	incremental, w, h := readUpdateRequest(t, server)
+	if incremental || w != 640 || h != 480 {
+		t.Fatalf("bad first request: %v %d %d", incremental, w, h)
+	}
+
+	// An empty update should trigger an incremental request
+	server.Write([]byte{0, 0, 0, 0})
+	incremental, w, h = readUpdateRequest(t, server)
+	if !incremental || w != 640 || h != 480 {
+		t.Fatalf("bad second request: %v %d %d", incremental, w, h)
+	}
+
+	// A resize should trigger a full request of the new size
+	var resize bytes.Buffer
+	resize.Write([]byte{0, 0, 0, 1})
+	binary.Write(&resize, binary.BigEndian, []uint16{0, 0, 800, 600})
+	binary.Write(&resize, binary.BigEndian, int32(-223))
+	server.Write(resize.Bytes())
+	incremental, w, h = readUpdateRequest(t, server)
+	if incremental || w != 800 || h != 600 {
+		t.Fatalf("bad resize request: %v %d %d", incremental, w, h)
+	}
+
+	if w, h := conn.FrameBufferSize(); w != 800 || h != 600 {
+		t.Fatalf("bad size: %d %d", w, h)
+	}
+
+	// While paused, no requests should be sent
+	r.Pause()
+	server.Write([]byte{0, 0, 0, 0})
+	server.SetReadDeadline(time.Now().Add(50 * time.Millisecond))
+	var b [1]byte
+	if _, err := server.Read(b[:]); err == nil {
+		t.Fatal("request sent while paused")
+	}
+
+	r.Resume()
+	incremental, _, _ = readUpdateRequest(t, server)
+	if !incremental {
+		t.Fatal("request after resume should be incremental")
+	}
+
+	if err := r.Stop(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := conn.StartRefresher(nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestRefresher_MaxFPS(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 640, 480)
+	defer conn.Close()
+
+	r, err := conn.StartRefresher(&RefresherConfig{MaxFPS: 10})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	defer r.Stop()
+
+	readUpdateRequest(t, server)
+	start := time.Now()
+	server.Write([]byte{0, 0, 0, 0})
+	readUpdateRequest(t, server)
+
+	if d := time.Since(start); d < 90*time.Millisecond {
+		t.Fatalf("request sent too quickly: %s", d)
+	}
+}
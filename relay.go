@@ -0,0 +1,649 @@
+package vnc
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+
+	"github.com/mitchellh/go-vnc/keysym"
+)
+
+// A ClientMessage is an input or control message sent by a client, which
+// a RelayFilter can inspect, rewrite or drop. It is one of
+// *KeyEventMessage, *PointerEventMessage, *ClientCutTextMessage,
+// *SetDesktopSizeMessage and *XvpMessage.
+type ClientMessage interface {
+	// The type of the message that is sent down on the wire.
+	Type() uint8
+
+	// bytes returns the message as it is sent on the wire.
+	bytes() []byte
+}
+
+// KeyEventMessage is a key press or release. See RFC 6143 Section 7.5.4
+//
+// QEMU extended key events are also relayed as a KeyEventMessage, with
+// the keycode set. They are forwarded as QEMU extended key events again,
+// unless the keycode is changed to zero.
+type KeyEventMessage struct {
+	Down    bool
+	Keysym  uint32
+	Keycode uint32
+}
+
+func (m *KeyEventMessage) Type() uint8 {
+	if m.Keycode != 0 {
+		return 255
+	}
+
+	return 4
+}
+
+func (m *KeyEventMessage) bytes() []byte {
+	var down uint8
+	if m.Down {
+		down = 1
+	}
+
+	var buf bytes.Buffer
+	if m.Keycode != 0 {
+		binary.Write(&buf, binary.BigEndian, []uint8{255, 0, 0, down})
+		binary.Write(&buf, binary.BigEndian, []uint32{m.Keysym, m.Keycode})
+	} else {
+		binary.Write(&buf, binary.BigEndian, []uint8{4, down, 0, 0})
+		binary.Write(&buf, binary.BigEndian, m.Keysym)
+	}
+
+	return buf.Bytes()
+}
+
+// PointerEventMessage is a pointer movement or button press or release.
+// See RFC 6143 Section 7.5.5
+//
+// Only the first eight buttons are relayed, since the relay doesn't pass
+// the extended mouse buttons extension on to the server.
+type PointerEventMessage struct {
+	Mask ButtonMask
+	X, Y uint16
+}
+
+func (*PointerEventMessage) Type() uint8 {
+	return 5
+}
+
+func (m *PointerEventMessage) bytes() []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, []uint8{5, uint8(m.Mask)})
+	binary.Write(&buf, binary.BigEndian, []uint16{m.X, m.Y})
+	return buf.Bytes()
+}
+
+// ClientCutTextMessage tells the server that the client has new text in
+// its cut buffer. See RFC 6143 Section 7.5.6
+type ClientCutTextMessage struct {
+	// Text is the raw text, which is Latin-1 unless Extended is set.
+	Text []byte
+
+	// Extended is set if the message is in the extended clipboard
+	// format, in which case Text holds the extended message.
+	Extended bool
+}
+
+func (*ClientCutTextMessage) Type() uint8 {
+	return 6
+}
+
+func (m *ClientCutTextMessage) bytes() []byte {
+	length := int32(len(m.Text))
+	if m.Extended {
+		length = -length
+	}
+
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, []uint8{6, 0, 0, 0})
+	binary.Write(&buf, binary.BigEndian, length)
+	buf.Write(m.Text)
+	return buf.Bytes()
+}
+
+// SetDesktopSizeMessage asks the server to change the size and the screen
+// layout of the framebuffer, from the ExtendedDesktopSize extension.
+type SetDesktopSizeMessage struct {
+	Width, Height uint16
+	Screens       []Screen
+}
+
+// Screen is a screen of the framebuffer in a SetDesktopSizeMessage.
+type Screen struct {
+	ID            uint32
+	X, Y          uint16
+	Width, Height uint16
+	Flags         uint32
+}
+
+func (*SetDesktopSizeMessage) Type() uint8 {
+	return 251
+}
+
+func (m *SetDesktopSizeMessage) bytes() []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, []uint8{251, 0})
+	binary.Write(&buf, binary.BigEndian, []uint16{m.Width, m.Height})
+	binary.Write(&buf, binary.BigEndian, []uint8{uint8(len(m.Screens)), 0})
+	binary.Write(&buf, binary.BigEndian, m.Screens)
+	return buf.Bytes()
+}
+
+// XvpMessage asks the server to shut down, reboot or reset the machine,
+// from the xvp extension.
+type XvpMessage struct {
+	Version uint8
+	Code    uint8
+}
+
+func (*XvpMessage) Type() uint8 {
+	return 250
+}
+
+func (m *XvpMessage) bytes() []byte {
+	return []byte{250, 0, m.Version, m.Code}
+}
+
+// A RelayFilter inspects the input and control messages relayed from a
+// client to the server.
+type RelayFilter interface {
+	// Filter returns the message to forward to the server, which may be
+	// the message itself, a modified or a new message, or nil to drop
+	// the message.
+	Filter(msg ClientMessage) ClientMessage
+}
+
+// FilterFunc is an adapter to use a function as a RelayFilter.
+type FilterFunc func(msg ClientMessage) ClientMessage
+
+func (f FilterFunc) Filter(msg ClientMessage) ClientMessage {
+	return f(msg)
+}
+
+// ViewOnly is a RelayFilter that drops all keyboard, pointer and
+// clipboard input, along with requests to resize the desktop or to shut
+// the machine down, so that the client can only watch the session.
+var ViewOnly RelayFilter = FilterFunc(func(ClientMessage) ClientMessage {
+	return nil
+})
+
+// BlockClipboard is a RelayFilter that drops the clipboard contents sent
+// by the client.
+var BlockClipboard RelayFilter = FilterFunc(func(msg ClientMessage) ClientMessage {
+	if _, ok := msg.(*ClientCutTextMessage); ok {
+		return nil
+	}
+
+	return msg
+})
+
+// ChainFilters returns a RelayFilter that applies the filters in order,
+// until one of them drops the message.
+func ChainFilters(filters ...RelayFilter) RelayFilter {
+	return FilterFunc(func(msg ClientMessage) ClientMessage {
+		for _, f := range filters {
+			if msg = f.Filter(msg); msg == nil {
+				return nil
+			}
+		}
+
+		return msg
+	})
+}
+
+// NewCtrlAltDelFilter returns a RelayFilter that drops presses of Delete
+// while Control and Alt are held down, along with their releases. Since
+// it keeps track of the keys that are held down, a new one must be used
+// for every session. Keys are recognized by keysym as well as by the
+// keycode of QEMU extended key events.
+func NewCtrlAltDelFilter() RelayFilter {
+	return &ctrlAltDelFilter{
+		pressed:      make(map[uint32]bool),
+		pressedCodes: make(map[uint32]bool),
+		blocked:      make(map[uint32]bool),
+		blockedCodes: make(map[uint32]bool),
+	}
+}
+
+// ctrlAltDelFilter keeps the keysyms and the keycodes of the keys that
+// are held down, and of the presses that were dropped. Zero, which is no
+// keysym or no keycode, is never kept.
+type ctrlAltDelFilter struct {
+	pressed      map[uint32]bool
+	pressedCodes map[uint32]bool
+	blocked      map[uint32]bool
+	blockedCodes map[uint32]bool
+}
+
+func (f *ctrlAltDelFilter) Filter(msg ClientMessage) ClientMessage {
+	key, ok := msg.(*KeyEventMessage)
+	if !ok {
+		return msg
+	}
+
+	if !key.Down {
+		blocked := f.blocked[key.Keysym] || f.blockedCodes[key.Keycode]
+		delete(f.pressed, key.Keysym)
+		delete(f.pressedCodes, key.Keycode)
+		delete(f.blocked, key.Keysym)
+		delete(f.blockedCodes, key.Keycode)
+		if blocked {
+			return nil
+		}
+
+		return msg
+	}
+
+	if key.Keysym != 0 {
+		f.pressed[key.Keysym] = true
+	}
+	if key.Keycode != 0 {
+		f.pressedCodes[key.Keycode] = true
+	}
+
+	held := func(syms ...uint32) bool {
+		for _, sym := range syms {
+			if f.pressed[sym] {
+				return true
+			}
+
+			if code, ok := USScancode(sym); ok && f.pressedCodes[code] {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if held(keysym.Control_L, keysym.Control_R) &&
+		held(keysym.Alt_L, keysym.Alt_R, keysym.Meta_L, keysym.Meta_R) &&
+		isDeleteKey(key) {
+		if key.Keysym != 0 {
+			f.blocked[key.Keysym] = true
+		}
+		if key.Keycode != 0 {
+			f.blockedCodes[key.Keycode] = true
+		}
+
+		return nil
+	}
+
+	return msg
+}
+
+// isDeleteKey returns whether a key event is for one of the Delete keys,
+// by keysym or by the keycode of a QEMU extended key event.
+func isDeleteKey(key *KeyEventMessage) bool {
+	for _, sym := range []uint32{keysym.Delete, keysym.KP_Delete} {
+		if key.Keysym == sym {
+			return true
+		}
+
+		if code, ok := USScancode(sym); ok && key.Keycode == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// A RelayConfig structure is used to configure a Relay.
+type RelayConfig struct {
+	// Dial connects to the upstream VNC server. It is called once for
+	// every client that connects to the relay.
+	Dial func() (net.Conn, error)
+
+	// Filter, if set, is called for every session and returns the filter
+	// for the input messages of the client. It may return nil to relay
+	// all messages.
+	Filter func(client net.Conn) RelayFilter
+
+	// MaxCutTextLength is the maximum length in bytes of the cut text
+	// messages sent by a client. Longer cut text is skipped without being
+	// read into memory, and isn't relayed. If zero, the maximum is 16MB.
+	MaxCutTextLength uint32
+
+	// ErrorLog is used to log the errors of sessions that failed. If it
+	// is nil, the standard logger is used.
+	ErrorLog *log.Logger
+}
+
+// Relay sits between VNC clients and a VNC server and passes messages
+// through, filtering the keyboard, pointer and clipboard input of the
+// clients. Unlike a Proxy, the handshake is passed through, so clients
+// authenticate with the server directly.
+//
+// Clients must use protocol version 3.7 or later, and authenticate with
+// either no authentication or VNC authentication, since the relay can't
+// follow the other authentication schemes. The extended mouse buttons
+// extension isn't relayed, so pointer events only have eight buttons.
+// Clients with a filter always share the desktop, so that they can't
+// disconnect the other clients by asking for exclusive access.
+type Relay struct {
+	config *RelayConfig
+}
+
+// NewRelay returns a Relay with the given configuration, which must not
+// be modified afterwards.
+func NewRelay(cfg *RelayConfig) *Relay {
+	return &Relay{config: cfg}
+}
+
+// Serve accepts connections from the listener and serves each of them in
+// a new goroutine. It returns when accepting a connection fails.
+func (r *Relay) Serve(l net.Listener) error {
+	return serveListener(l, r.ServeConn, r.config.ErrorLog)
+}
+
+// ServeConn serves a single client connection, and closes it when the
+// session ends. It returns nil if the session ended because either side
+// closed its connection.
+func (r *Relay) ServeConn(c net.Conn) error {
+	defer c.Close()
+
+	if r.config.Dial == nil {
+		return errors.New("no dial function configured")
+	}
+
+	upstream, err := r.config.Dial()
+	if err != nil {
+		return err
+	}
+	defer upstream.Close()
+
+	var filter RelayFilter
+	if r.config.Filter != nil {
+		filter = r.config.Filter(c)
+	}
+
+	maxCutText := r.config.MaxCutTextLength
+	if maxCutText == 0 {
+		maxCutText = defaultMaxCutTextLength
+	}
+
+	return runSession(c, upstream,
+		func() error {
+			return relayClient(bufio.NewReader(c), upstream, filter, maxCutText)
+		},
+		func() error {
+			_, err := io.Copy(c, upstream)
+			return err
+		})
+}
+
+// relayClient relays the handshake and the messages of a client to the
+// server, filtering the input messages and skipping cut text longer than
+// maxCutText bytes.
+func relayClient(r *bufio.Reader, w io.Writer, filter RelayFilter, maxCutText uint32) error {
+	if err := relayClientHandshake(r, w, filter != nil); err != nil {
+		return err
+	}
+
+	for {
+		raw, msg, err := readClientMessage(r, maxCutText)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if raw == nil {
+			continue
+		}
+
+		if raw[0] == 2 {
+			raw = removeEncodings(raw, unrelayedEncodings)
+		}
+
+		if msg != nil && filter != nil {
+			msg = filter.Filter(msg)
+			if msg == nil {
+				continue
+			}
+
+			raw = msg.bytes()
+		}
+
+		if _, err := w.Write(raw); err != nil {
+			return err
+		}
+	}
+}
+
+// relayClientHandshake relays the data the client sends during the
+// handshake, up to and including the ClientInit message. Each part is
+// forwarded as soon as it is read, since the server responds to it. If
+// shared is set, the ClientInit message asks to share the desktop,
+// whatever the client asked for.
+func relayClientHandshake(r *bufio.Reader, w io.Writer, shared bool) error {
+	forward := func(n int) ([]byte, error) {
+		data := make([]byte, n)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+
+		_, err := w.Write(data)
+		return data, err
+	}
+
+	// 7.1.1 ProtocolVersion
+	protocolVersion, err := forward(pvLen)
+	if err != nil {
+		return err
+	}
+
+	major, minor, err := parseProtocolVersion(protocolVersion)
+	if err != nil {
+		return err
+	}
+	if major != 3 || minor < 7 {
+		return fmt.Errorf("unsupported client version: %d.%d", major, minor)
+	}
+
+	// 7.1.2 Security Handshake
+	securityType, err := forward(1)
+	if err != nil {
+		return err
+	}
+
+	switch securityType[0] {
+	case 1:
+	case 2:
+		// The response to the VNC authentication challenge
+		if _, err := forward(16); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported security type: %d", securityType[0])
+	}
+
+	// 7.3.1 ClientInit
+	clientInit := make([]byte, 1)
+	if _, err := io.ReadFull(r, clientInit); err != nil {
+		return err
+	}
+
+	if shared {
+		clientInit[0] = 1
+	}
+
+	_, err = w.Write(clientInit)
+	return err
+}
+
+// unrelayedEncodings are the encodings that are removed from the
+// SetEncodings messages of clients, because the server would confirm them
+// in framebuffer updates the relay doesn't decode, and they change the
+// format of the client messages. These are the extended mouse buttons,
+// which add a byte to pointer events, and GII.
+var unrelayedEncodings = []int32{-316, -305}
+
+// readClientMessage reads a single client message. It returns the raw
+// message, and the parsed message if it is an input or control message.
+// Cut text longer than maxCutText bytes is skipped, in which case the raw
+// message is nil.
+func readClientMessage(r *bufio.Reader, maxCutText uint32) ([]byte, ClientMessage, error) {
+	messageType, err := r.Peek(1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The length of the fixed size part of each message
+	var length int
+	switch messageType[0] {
+	case 0: // SetPixelFormat
+		length = 20
+	case 2: // SetEncodings
+		length = 4
+	case 3: // FramebufferUpdateRequest
+		length = 10
+	case 4: // KeyEvent
+		length = 8
+	case 5: // PointerEvent
+		length = 6
+	case 6: // ClientCutText
+		length = 8
+	case 150: // EnableContinuousUpdates
+		length = 10
+	case 248: // Fence
+		length = 9
+	case 250: // xvp
+		length = 4
+	case 251: // SetDesktopSize
+		length = 8
+	case 255: // QEMU
+		length = 4
+	default:
+		return nil, nil, fmt.Errorf("unsupported client message type: %d", messageType[0])
+	}
+
+	raw := make([]byte, length)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return nil, nil, unexpectedEOF(err)
+	}
+
+	// The length of the variable size part of each message
+	var extra int
+	switch raw[0] {
+	case 2:
+		extra = 4 * int(binary.BigEndian.Uint16(raw[2:]))
+	case 6:
+		// A negative length marks the extended clipboard format. The
+		// length is widened first, since -2^31 can't be negated as int32.
+		length := int64(int32(binary.BigEndian.Uint32(raw[4:])))
+		if length < 0 {
+			length = -length
+		}
+
+		if length > int64(maxCutText) {
+			if _, err := r.Discard(int(length)); err != nil {
+				return nil, nil, unexpectedEOF(err)
+			}
+
+			return nil, nil, nil
+		}
+
+		extra = int(length)
+	case 248:
+		extra = int(raw[8])
+	case 251:
+		extra = 16 * int(raw[6])
+	case 255:
+		switch raw[1] {
+		case 0: // Extended key event
+			extra = 8
+		case 1: // Audio
+			if binary.BigEndian.Uint16(raw[2:]) == 2 {
+				// Set format
+				extra = 6
+			}
+		default:
+			return nil, nil, fmt.Errorf("unsupported QEMU client message: %d", raw[1])
+		}
+	}
+
+	if extra > 0 {
+		// The buffer grows as the data arrives, so that a bogus length
+		// doesn't allocate more memory than the client actually sent.
+		buf := bytes.NewBuffer(raw)
+		if _, err := io.CopyN(buf, r, int64(extra)); err != nil {
+			return nil, nil, unexpectedEOF(err)
+		}
+
+		raw = buf.Bytes()
+	}
+
+	var msg ClientMessage
+	switch raw[0] {
+	case 4:
+		msg = &KeyEventMessage{
+			Down:   raw[1] != 0,
+			Keysym: binary.BigEndian.Uint32(raw[4:]),
+		}
+	case 5:
+		msg = &PointerEventMessage{
+			Mask: ButtonMask(raw[1]),
+			X:    binary.BigEndian.Uint16(raw[2:]),
+			Y:    binary.BigEndian.Uint16(raw[4:]),
+		}
+	case 6:
+		msg = &ClientCutTextMessage{
+			Text:     raw[8:],
+			Extended: int32(binary.BigEndian.Uint32(raw[4:])) < 0,
+		}
+	case 250:
+		msg = &XvpMessage{Version: raw[2], Code: raw[3]}
+	case 251:
+		size := &SetDesktopSizeMessage{
+			Width:   binary.BigEndian.Uint16(raw[2:]),
+			Height:  binary.BigEndian.Uint16(raw[4:]),
+			Screens: make([]Screen, raw[6]),
+		}
+		binary.Read(bytes.NewReader(raw[8:]), binary.BigEndian, size.Screens)
+
+		msg = size
+	case 255:
+		if raw[1] == 0 {
+			msg = &KeyEventMessage{
+				Down:    binary.BigEndian.Uint16(raw[2:]) != 0,
+				Keysym:  binary.BigEndian.Uint32(raw[4:]),
+				Keycode: binary.BigEndian.Uint32(raw[8:]),
+			}
+		}
+	}
+
+	return raw, msg, nil
+}
+
+// removeEncodings returns a SetEncodings message without the given
+// encoding types.
+func removeEncodings(raw []byte, encTypes []int32) []byte {
+	result := append([]byte{}, raw[:4]...)
+	count := 0
+
+next:
+	for i := 4; i+4 <= len(raw); i += 4 {
+		encType := int32(binary.BigEndian.Uint32(raw[i:]))
+		for _, t := range encTypes {
+			if encType == t {
+				continue next
+			}
+		}
+
+		result = append(result, raw[i:i+4]...)
+		count++
+	}
+
+	binary.BigEndian.PutUint16(result[2:], uint16(count))
+	return result
+}
@@ -0,0 +1,279 @@
+package vnc
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/mitchellh/go-vnc/keysym"
+)
+
+// newTestRelay connects a client through a relay with the given filter to
+// a test server, and returns the client and the server end.
+func newTestRelay(t *testing.T, filter RelayFilter) (*ClientConn, net.Conn) {
+	upstream, server := net.Pipe()
+	relay := NewRelay(&RelayConfig{
+		Dial: func() (net.Conn, error) {
+			go serveTestHandshake(server, 8, 4)
+			return upstream, nil
+		},
+		Filter: func(net.Conn) RelayFilter {
+			return filter
+		},
+	})
+
+	client, relayed := net.Pipe()
+	go relay.ServeConn(relayed)
+
+	conn, err := Client(client, &ClientConfig{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	return conn, server
+}
+
+func TestRelay(t *testing.T) {
+	conn, server := newTestRelay(t, ChainFilters(NewCtrlAltDelFilter(), BlockClipboard))
+	defer conn.Close()
+	defer server.Close()
+
+	if conn.DesktopName != "test" {
+		t.Fatalf("bad desktop name: %q", conn.DesktopName)
+	}
+
+	go func() {
+		conn.KeyEvent(keysym.Control_L, true)
+		conn.KeyEvent(keysym.Alt_L, true)
+		conn.KeyEvent(keysym.Delete, true)
+		conn.KeyEvent(keysym.Delete, false)
+		conn.CutText("secret")
+		conn.PointerEvent(ButtonLeft, 2, 3)
+	}()
+
+	expected := []byte{
+		4, 1, 0, 0, 0, 0, 0xff, 0xe3,
+		4, 1, 0, 0, 0, 0, 0xff, 0xe9,
+		5, 1, 0, 2, 0, 3,
+	}
+
+	actual := make([]byte, len(expected))
+	if _, err := io.ReadFull(server, actual); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !bytes.Equal(actual, expected) {
+		t.Fatalf("bad messages: %v", actual)
+	}
+}
+
+func TestCtrlAltDelFilter_QEMUKeycodes(t *testing.T) {
+	f := NewCtrlAltDelFilter()
+
+	// QEMU extended key events with no keysym, only XT keycodes
+	cases := []struct {
+		key     KeyEventMessage
+		relayed bool
+	}{
+		{KeyEventMessage{Down: true, Keycode: 0x1d}, true},
+		{KeyEventMessage{Down: true, Keycode: 0x38}, true},
+		{KeyEventMessage{Down: true, Keycode: 0xd3}, false},
+		{KeyEventMessage{Down: false, Keycode: 0xd3}, false},
+
+		// Delete by keysym, while the modifiers are held by keycode
+		{KeyEventMessage{Down: true, Keysym: keysym.Delete}, false},
+		{KeyEventMessage{Down: false, Keysym: keysym.Delete}, false},
+
+		{KeyEventMessage{Down: false, Keycode: 0x38}, true},
+		{KeyEventMessage{Down: true, Keycode: 0xd3}, true},
+		{KeyEventMessage{Down: false, Keycode: 0xd3}, true},
+	}
+
+	for i, tc := range cases {
+		key := tc.key
+		if relayed := f.Filter(&key) != nil; relayed != tc.relayed {
+			t.Fatalf("%d: expected relayed %t for %#v", i, tc.relayed, tc.key)
+		}
+	}
+}
+
+func TestRelay_ViewOnly(t *testing.T) {
+	conn, server := newTestRelay(t, ViewOnly)
+	defer conn.Close()
+	defer server.Close()
+
+	go func() {
+		conn.KeyEvent(keysym.Return, true)
+		conn.PointerEvent(ButtonLeft, 2, 3)
+
+		// xvp shutdown and SetDesktopSize with one screen
+		conn.c.Write([]byte{250, 0, 1, 2})
+		conn.c.Write(append([]byte{251, 0, 0, 16, 0, 8, 1, 0}, make([]byte, 16)...))
+
+		conn.FramebufferUpdateRequest(true, 0, 0, 8, 4)
+	}()
+
+	actual := make([]byte, 10)
+	if _, err := io.ReadFull(server, actual); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !bytes.Equal(actual, []byte{3, 1, 0, 0, 0, 0, 0, 8, 0, 4}) {
+		t.Fatalf("bad message: %v", actual)
+	}
+}
+
+func TestRelayClientHandshake_Shared(t *testing.T) {
+	for _, shared := range []bool{false, true} {
+		// An exclusive ClientInit after the handshake without authentication
+		data := append([]byte("RFB 003.008\n"), 1, 0)
+
+		var w bytes.Buffer
+		if err := relayClientHandshake(bufio.NewReader(bytes.NewReader(data)), &w, shared); err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		expected := append([]byte{}, data...)
+		if shared {
+			expected[len(expected)-1] = 1
+		}
+
+		if !bytes.Equal(w.Bytes(), expected) {
+			t.Fatalf("bad handshake for shared %t: %v", shared, w.Bytes())
+		}
+	}
+}
+
+func TestReadClientMessage(t *testing.T) {
+	cases := []struct {
+		raw []byte
+		msg ClientMessage
+	}{
+		{
+			[]byte{5, 0x81, 0, 1, 0, 2},
+			&PointerEventMessage{Mask: ButtonLeft | Button8, X: 1, Y: 2},
+		},
+		{
+			[]byte{255, 0, 0, 1, 0, 0, 0xff, 0xff, 0, 0, 0, 0xd3},
+			&KeyEventMessage{Down: true, Keysym: keysym.Delete, Keycode: 0xd3},
+		},
+		{
+			[]byte{6, 0, 0, 0, 0xff, 0xff, 0xff, 0xfe, 1, 2},
+			&ClientCutTextMessage{Text: []byte{1, 2}, Extended: true},
+		},
+		{
+			[]byte{250, 0, 1, 3},
+			&XvpMessage{Version: 1, Code: 3},
+		},
+		{
+			[]byte{
+				251, 0, 0x04, 0, 0x03, 0, 2, 0,
+				0, 0, 0, 1, 0, 0, 0, 0, 0x02, 0, 0x03, 0, 0, 0, 0, 0,
+				0, 0, 0, 2, 0x02, 0, 0, 0, 0x02, 0, 0x03, 0, 0, 0, 0, 0,
+			},
+			&SetDesktopSizeMessage{
+				Width:  1024,
+				Height: 768,
+				Screens: []Screen{
+					{ID: 1, Width: 512, Height: 768},
+					{ID: 2, X: 512, Width: 512, Height: 768},
+				},
+			},
+		},
+		{
+			[]byte{248, 0, 0, 0, 0, 0, 0, 1, 2, 9, 9},
+			nil,
+		},
+	}
+
+	for _, tc := range cases {
+		// Add another message to check that no more than one is read
+		data := append(append([]byte{}, tc.raw...), 3, 0, 0, 0, 0, 0, 0, 1, 0, 1)
+		raw, msg, err := readClientMessage(bufio.NewReader(bytes.NewReader(data)), 16)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if !bytes.Equal(raw, tc.raw) {
+			t.Fatalf("bad raw message: %v", raw)
+		}
+
+		if tc.msg == nil {
+			if msg != nil {
+				t.Fatalf("unexpected message: %#v", msg)
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(msg, tc.msg) {
+			t.Fatalf("bad message: %#v", msg)
+		}
+
+		if !bytes.Equal(msg.bytes(), tc.raw) {
+			t.Fatalf("bad message %#v: %v", msg, msg.bytes())
+		}
+	}
+}
+
+func TestRemoveEncodings(t *testing.T) {
+	raw := []byte{
+		2, 0, 0, 4,
+		0, 0, 0, 0,
+		0xff, 0xff, 0xfe, 0xc4,
+		0xff, 0xff, 0xff, 0x21,
+		0xff, 0xff, 0xfe, 0xcf,
+	}
+
+	expected := []byte{
+		2, 0, 0, 2,
+		0, 0, 0, 0,
+		0xff, 0xff, 0xff, 0x21,
+	}
+
+	if actual := removeEncodings(raw, unrelayedEncodings); !bytes.Equal(actual, expected) {
+		t.Fatalf("bad message: %v", actual)
+	}
+}
+
+func TestReadClientMessage_LongCutText(t *testing.T) {
+	cases := [][]byte{
+		// Longer than the maximum
+		append([]byte{6, 0, 0, 0, 0, 0, 0, 17}, make([]byte, 17)...),
+		append([]byte{6, 0, 0, 0, 0xff, 0xff, 0xff, 0xef}, make([]byte, 17)...),
+
+		// The most negative length, which can't be negated as int32, with
+		// less data than it declares
+		{6, 0, 0, 0, 0x80, 0, 0, 0},
+	}
+
+	for _, data := range cases {
+		// The cut text is skipped, and the next message is read after it
+		next := []byte{3, 0, 0, 0, 0, 0, 0, 1, 0, 1}
+		r := bufio.NewReader(bytes.NewReader(append(append([]byte{}, data...), next...)))
+
+		raw, msg, err := readClientMessage(r, 16)
+		if len(data) == 8 {
+			if err != io.ErrUnexpectedEOF {
+				t.Fatalf("expected unexpected EOF, got: %v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if raw != nil || msg != nil {
+			t.Fatalf("cut text not skipped: %v", raw)
+		}
+
+		raw, _, err = readClientMessage(r, 16)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if !bytes.Equal(raw, next) {
+			t.Fatalf("bad raw message: %v", raw)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package vnc
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// trackScreen starts keeping a local copy of the framebuffer, which is
+// updated from the rectangles of every FramebufferUpdateMessage. It
+// returns true if tracking was started by this call.
+func (c *ClientConn) trackScreen() bool {
+	c.screenLock.Lock()
+	defer c.screenLock.Unlock()
+
+	if c.screen != nil {
+		return false
+	}
+
+	width, height := c.FrameBufferSize()
+	c.screen = image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+	return true
+}
+
+// Snapshot returns a copy of the framebuffer. The first call starts
+// keeping a local copy of the framebuffer, so the snapshot is black until
+// the server sends the first update. Functions that wait for the screen,
+// such as WaitForImage, make sure updates are requested from the server.
+func (c *ClientConn) Snapshot() *image.RGBA {
+	c.trackScreen()
+
+	c.screenLock.Lock()
+	defer c.screenLock.Unlock()
+
+	result := image.NewRGBA(c.screen.Bounds())
+	copy(result.Pix, c.screen.Pix)
+	return result
+}
+
+// snapshotRegion returns a copy of a region of the framebuffer, clipped
+// to the framebuffer bounds.
+func (c *ClientConn) snapshotRegion(r image.Rectangle) *image.RGBA {
+	c.trackScreen()
+
+	c.screenLock.Lock()
+	defer c.screenLock.Unlock()
+
+	r = r.Intersect(c.screen.Bounds())
+	result := image.NewRGBA(r)
+	draw.Draw(result, r, c.screen, r.Min, draw.Src)
+	return result
+}
+
+// updateScreen applies a message read from the server to the local copy
+// of the framebuffer, if one is kept, and notifies the screen listeners
+// of the areas that changed. This is called from the main loop.
+func (c *ClientConn) updateScreen(msg ServerMessage) {
+	update, ok := msg.(*FramebufferUpdateMessage)
+	if !ok {
+		return
+	}
+
+	var changed []image.Rectangle
+
+	c.screenLock.Lock()
+	if c.screen == nil {
+		c.screenLock.Unlock()
+		return
+	}
+
+	for _, rect := range update.Rectangles {
+		switch enc := rect.Enc.(type) {
+		case *DesktopSizePseudoEncoding:
+			resized := image.NewRGBA(image.Rect(0, 0, int(rect.Width), int(rect.Height)))
+			draw.Draw(resized, resized.Bounds(), c.screen, image.Point{}, draw.Src)
+			c.screen = resized
+			changed = append(changed, resized.Bounds())
+		case *RawEncoding:
+			changed = append(changed, c.drawColors(&rect, enc.Colors)...)
+		case *RawImageEncoding:
+			changed = append(changed, c.drawImage(enc.Image)...)
+		}
+	}
+	c.screenLock.Unlock()
+
+	if len(changed) == 0 {
+		return
+	}
+
+	c.screenListenersLock.Lock()
+	fs := make([]func([]image.Rectangle), 0, len(c.screenListeners))
+	for _, f := range c.screenListeners {
+		fs = append(fs, f)
+	}
+	c.screenListenersLock.Unlock()
+
+	for _, f := range fs {
+		f(changed)
+	}
+}
+
+// listenScreen registers a function that is called from the main loop
+// with the areas of the local copy of the framebuffer that changed. The
+// returned function removes the listener again. Listeners must not block.
+func (c *ClientConn) listenScreen(f func([]image.Rectangle)) func() {
+	c.screenListenersLock.Lock()
+	defer c.screenListenersLock.Unlock()
+
+	if c.screenListeners == nil {
+		c.screenListeners = make(map[int]func([]image.Rectangle))
+	}
+
+	id := c.nextScreenListener
+	c.nextScreenListener++
+	c.screenListeners[id] = f
+
+	return func() {
+		c.screenListenersLock.Lock()
+		defer c.screenListenersLock.Unlock()
+		delete(c.screenListeners, id)
+	}
+}
+
+// drawColors draws the colors of a rectangle onto the local copy of the
+// framebuffer. It returns the areas of the pixels that changed.
+func (c *ClientConn) drawColors(rect *Rectangle, colors []Color) []image.Rectangle {
+	var changed []image.Rectangle
+	r := image.Rect(
+		int(rect.X), int(rect.Y),
+		int(rect.X)+int(rect.Width), int(rect.Y)+int(rect.Height)).Intersect(c.screen.Bounds())
+	width := int(rect.Width)
+
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		first := -1
+		for x := r.Min.X; x <= r.Max.X; x++ {
+			same := true
+			if x < r.Max.X {
+				rgba := c.rgba(colors[(y-int(rect.Y))*width+x-int(rect.X)])
+				if c.screen.RGBAAt(x, y) != rgba {
+					c.screen.SetRGBA(x, y, rgba)
+					same = false
+				}
+			}
+
+			if !same && first < 0 {
+				first = x
+			} else if same && first >= 0 {
+				changed = addChangedRun(changed, image.Rect(first, y, x, y+1))
+				first = -1
+			}
+		}
+	}
+
+	return changed
+}
+
+// drawImage draws an image decoded by RawImageEncoding onto the local copy
+// of the framebuffer. It returns the areas of the pixels that changed.
+func (c *ClientConn) drawImage(img *image.RGBA) []image.Rectangle {
+	var changed []image.Rectangle
+	r := img.Rect.Intersect(c.screen.Bounds())
+	if r.Empty() {
+		return changed
+	}
+
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		src := img.Pix[img.PixOffset(r.Min.X, y):img.PixOffset(r.Max.X, y)]
+		dst := c.screen.Pix[c.screen.PixOffset(r.Min.X, y):c.screen.PixOffset(r.Max.X, y)]
+
+		// Find the runs of pixels of the row that differ
+		first := -1
+		for i := 0; i <= len(src); i += 4 {
+			same := i == len(src) ||
+				src[i] == dst[i] && src[i+1] == dst[i+1] && src[i+2] == dst[i+2] && src[i+3] == dst[i+3]
+
+			if !same && first < 0 {
+				first = i / 4
+			} else if same && first >= 0 {
+				changed = addChangedRun(changed, image.Rect(r.Min.X+first, y, r.Min.X+i/4, y+1))
+				first = -1
+			}
+		}
+
+		copy(dst, src)
+	}
+
+	return changed
+}
+
+// addChangedRun adds a run of changed pixels in a row to the changed
+// areas, which are ordered by row. A run below an area with the same
+// columns extends the area, so that a changed block is a single area.
+func addChangedRun(changed []image.Rectangle, run image.Rectangle) []image.Rectangle {
+	for i := len(changed) - 1; i >= 0 && changed[i].Max.Y >= run.Min.Y; i-- {
+		r := &changed[i]
+		if r.Max.Y == run.Min.Y && r.Min.X == run.Min.X && r.Max.X == run.Max.X {
+			r.Max.Y = run.Max.Y
+			return changed
+		}
+	}
+
+	return append(changed, run)
+}
+
+// rgba converts a color decoded by RawEncoding to 8 bits per channel.
+func (c *ClientConn) rgba(col Color) color.RGBA {
+	// Colors are opaque, so they don't need to be premultiplied.
+	n := col.NRGBA(&c.PixelFormat)
+	return color.RGBA{n.R, n.G, n.B, n.A}
+}
+
+// requestUpdates makes sure the server sends framebuffer updates, by
+// starting a Refresher if none is running. If the local copy of the
+// framebuffer was just started, a full update is requested. The returned
+// function stops the Refresher again, if one was started.
+func (c *ClientConn) requestUpdates(newScreen bool) (func(), error) {
+	r, err := c.StartRefresher(nil)
+	if err == ErrRefresherRunning {
+		if newScreen {
+			width, height := c.FrameBufferSize()
+			err = c.FramebufferUpdateRequest(false, 0, 0, width, height)
+		} else {
+			err = nil
+		}
+
+		return func() {}, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return func() { r.Stop() }, nil
+}
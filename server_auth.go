@@ -0,0 +1,76 @@
+package vnc
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net"
+)
+
+// errAuthFailed is returned by ServerAuth handshakes when the client
+// failed to authenticate.
+var errAuthFailed = errors.New("authentication failed")
+
+// A ServerAuth implements a method of authenticating clients, for use by
+// servers such as a Proxy.
+type ServerAuth interface {
+	// SecurityType returns the byte identifier sent to the client to
+	// identify this authentication scheme.
+	SecurityType() uint8
+
+	// Handshake is called when the client chose this authentication
+	// scheme, and performs the authentication handshake. It returns an
+	// error if the client failed to authenticate. (see 7.2.1)
+	Handshake(net.Conn) error
+}
+
+// ServerAuthNone is the "none" authentication. See 7.2.1
+type ServerAuthNone byte
+
+func (*ServerAuthNone) SecurityType() uint8 {
+	return 1
+}
+
+func (*ServerAuthNone) Handshake(net.Conn) error {
+	return nil
+}
+
+// ServerPasswordAuth is VNC authentication, 7.2.2. Only the first eight
+// characters of the password are used by the protocol.
+type ServerPasswordAuth struct {
+	Password string
+}
+
+func (p *ServerPasswordAuth) SecurityType() uint8 {
+	return 2
+}
+
+func (p *ServerPasswordAuth) Handshake(c net.Conn) error {
+	challenge := make([]byte, 16)
+	if _, err := rand.Read(challenge); err != nil {
+		return err
+	}
+
+	if _, err := c.Write(challenge); err != nil {
+		return err
+	}
+
+	response := make([]byte, 16)
+	if _, err := io.ReadFull(c, response); err != nil {
+		return err
+	}
+
+	// The client encrypts the challenge with the password as key, so
+	// do the same and compare.
+	expected, err := new(PasswordAuth).encrypt(p.Password, challenge)
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare(response, expected) != 1 {
+		return errAuthFailed
+	}
+
+	return nil
+}
@@ -32,21 +32,23 @@ type Rectangle struct {
 	Enc    Encoding
 }
 
+// inFramebuffer returns whether the rectangle lies within the framebuffer.
+func (c *ClientConn) inFramebuffer(rect *Rectangle) bool {
+	return int(rect.X)+int(rect.Width) <= int(c.FrameBufferWidth) &&
+		int(rect.Y)+int(rect.Height) <= int(c.FrameBufferHeight)
+}
+
 func (*FramebufferUpdateMessage) Type() uint8 {
 	return 0
 }
 
 func (*FramebufferUpdateMessage) Read(c *ClientConn, r io.Reader) (ServerMessage, error) {
-	// Read off the padding
-	var padding [1]byte
-	if _, err := io.ReadFull(r, padding[:]); err != nil {
-		return nil, err
-	}
-
-	var numRects uint16
-	if err := binary.Read(r, binary.BigEndian, &numRects); err != nil {
+	// Read off the padding and the number of rectangles
+	var header [3]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
 		return nil, err
 	}
+	numRects := binary.BigEndian.Uint16(header[1:])
 
 	// Build the map of encodings supported
 	encMap := make(map[int32]Encoding)
@@ -54,34 +56,41 @@ func (*FramebufferUpdateMessage) Read(c *ClientConn, r io.Reader) (ServerMessage
 		encMap[enc.Type()] = enc
 	}
 
-	// We must always support the raw encoding
+	// We must always support the raw encoding, which may be decoded by
+	// RawImageEncoding instead.
 	rawEnc := new(RawEncoding)
-	encMap[rawEnc.Type()] = rawEnc
+	if _, ok := encMap[rawEnc.Type()]; !ok {
+		encMap[rawEnc.Type()] = rawEnc
+	}
 
 	rects := make([]Rectangle, numRects)
 	for i := uint16(0); i < numRects; i++ {
-		var encodingType int32
-
-		rect := &rects[i]
-		data := []interface{}{
-			&rect.X,
-			&rect.Y,
-			&rect.Width,
-			&rect.Height,
-			&encodingType,
+		var rectHeader [12]byte
+		if _, err := io.ReadFull(r, rectHeader[:]); err != nil {
+			return nil, err
 		}
 
-		for _, val := range data {
-			if err := binary.Read(r, binary.BigEndian, val); err != nil {
-				return nil, err
-			}
-		}
+		rect := &rects[i]
+		rect.X = binary.BigEndian.Uint16(rectHeader[0:])
+		rect.Y = binary.BigEndian.Uint16(rectHeader[2:])
+		rect.Width = binary.BigEndian.Uint16(rectHeader[4:])
+		rect.Height = binary.BigEndian.Uint16(rectHeader[6:])
+		encodingType := int32(binary.BigEndian.Uint32(rectHeader[8:]))
 
 		enc, ok := encMap[encodingType]
 		if !ok {
 			return nil, fmt.Errorf("unsupported encoding type: %d", encodingType)
 		}
 
+		// Rectangles of pseudo-encodings, which have negative types, don't
+		// necessarily describe an area of the framebuffer.
+		if encodingType >= 0 && !c.inFramebuffer(rect) {
+			return nil, fmt.Errorf(
+				"rectangle %dx%d at %d,%d is outside the %dx%d framebuffer",
+				rect.Width, rect.Height, rect.X, rect.Y,
+				c.FrameBufferWidth, c.FrameBufferHeight)
+		}
+
 		var err error
 		rect.Enc, err = enc.Read(c, rect, r)
 		if err != nil {
@@ -124,6 +133,12 @@ func (*SetColorMapEntriesMessage) Read(c *ClientConn, r io.Reader) (ServerMessag
 		return nil, err
 	}
 
+	if int(result.FirstColor)+int(numColors) > len(c.ColorMap) {
+		return nil, fmt.Errorf(
+			"color map entries %d to %d are outside the color map of %d entries",
+			result.FirstColor, int(result.FirstColor)+int(numColors)-1, len(c.ColorMap))
+	}
+
 	result.Colors = make([]Color, numColors)
 	for i := uint16(0); i < numColors; i++ {
 
@@ -161,10 +176,18 @@ func (*BellMessage) Read(*ClientConn, io.Reader) (ServerMessage, error) {
 }
 
 // ServerCutTextMessage indicates the server has new text in the cut buffer.
+// The text is converted from Latin-1 to UTF-8, with line endings
+// normalized to "\n".
+//
+// If the server supports the extended clipboard, the message may be in
+// the extended format instead, in which case Clipboard is set. The
+// extended clipboard messages are answered automatically, and Text is
+// set to the text format of a ClipboardProvide message.
 //
 // See RFC 6143 Section 7.6.4
 type ServerCutTextMessage struct {
-	Text string
+	Text      string
+	Clipboard *ExtendedClipboard
 }
 
 func (*ServerCutTextMessage) Type() uint8 {
@@ -173,20 +196,143 @@ func (*ServerCutTextMessage) Type() uint8 {
 
 func (*ServerCutTextMessage) Read(c *ClientConn, r io.Reader) (ServerMessage, error) {
 	// Read off the padding
-	var padding [1]byte
+	var padding [3]byte
 	if _, err := io.ReadFull(r, padding[:]); err != nil {
 		return nil, err
 	}
 
-	var textLength uint32
+	var textLength int32
 	if err := binary.Read(r, binary.BigEndian, &textLength); err != nil {
 		return nil, err
 	}
 
+	// A negative length marks the extended clipboard format
+	length := int64(textLength)
+	if length < 0 {
+		length = -length
+	}
+
+	if length > int64(c.maxCutTextLength()) {
+		return nil, fmt.Errorf("cut text too long: %d bytes, maximum is %d", length, c.maxCutTextLength())
+	}
+
+	if textLength < 0 {
+		buf, err := readFullBuffer(r, int(length))
+		if err != nil {
+			return nil, err
+		}
+
+		clipboard, err := readExtendedClipboard(*buf, c.maxCutTextLength())
+		putReadBuffer(buf)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.handleExtendedClipboard(clipboard); err != nil {
+			return nil, err
+		}
+
+		return &ServerCutTextMessage{
+			Text:      clipboard.Data[ClipboardText],
+			Clipboard: clipboard,
+		}, nil
+	}
+
 	textBytes := make([]uint8, textLength)
 	if err := binary.Read(r, binary.BigEndian, &textBytes); err != nil {
 		return nil, err
 	}
 
-	return &ServerCutTextMessage{string(textBytes)}, nil
+	return &ServerCutTextMessage{Text: decodeLatin1(textBytes)}, nil
+}
+
+// EndOfContinuousUpdatesMessage is sent by the server when continuous
+// updates are disabled, and once in reply to a SetEncodings message that
+// includes the ContinuousUpdatesPseudoEncoding to confirm that the server
+// supports continuous updates.
+type EndOfContinuousUpdatesMessage byte
+
+func (*EndOfContinuousUpdatesMessage) Type() uint8 {
+	return 150
+}
+
+func (*EndOfContinuousUpdatesMessage) Read(c *ClientConn, r io.Reader) (ServerMessage, error) {
+	c.setServerSupports(new(ContinuousUpdatesPseudoEncoding).Type())
+	return new(EndOfContinuousUpdatesMessage), nil
+}
+
+// FenceFlags is a bitwise mask of flags sent in a Fence message.
+type FenceFlags uint32
+
+// All available fence flags.
+const (
+	// FenceBlockBefore requests that all messages sent before the fence
+	// are processed before the fence is handled.
+	FenceBlockBefore FenceFlags = 1 << 0
+
+	// FenceBlockAfter requests that no messages sent after the fence are
+	// processed until the fence response has been sent.
+	FenceBlockAfter FenceFlags = 1 << 1
+
+	// FenceSyncNext requests that the message following the fence is
+	// handled as if it had FenceBlockBefore set.
+	FenceSyncNext FenceFlags = 1 << 2
+
+	// FenceRequest is set when the fence is a request, and is cleared
+	// in the response.
+	FenceRequest FenceFlags = 1 << 31
+)
+
+// fenceSupportedFlags are the flags that we support in fence requests
+// from the server. Since messages are handled one at a time in the order
+// they are received, all of them are trivially honored.
+const fenceSupportedFlags = FenceBlockBefore | FenceBlockAfter | FenceSyncNext
+
+// FenceMessage is a synchronization point in the message stream. The
+// first FenceMessage confirms that the server supports fences. Fence
+// requests from the server are answered automatically.
+type FenceMessage struct {
+	Flags   FenceFlags
+	Payload []byte
+}
+
+func (*FenceMessage) Type() uint8 {
+	return 248
+}
+
+func (*FenceMessage) Read(c *ClientConn, r io.Reader) (ServerMessage, error) {
+	// Read off the padding
+	var padding [3]byte
+	if _, err := io.ReadFull(r, padding[:]); err != nil {
+		return nil, err
+	}
+
+	var result FenceMessage
+	if err := binary.Read(r, binary.BigEndian, &result.Flags); err != nil {
+		return nil, err
+	}
+
+	var length uint8
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+
+	if length > maxFencePayload {
+		return nil, fmt.Errorf("fence payload too long: %d", length)
+	}
+
+	result.Payload = make([]byte, length)
+	if _, err := io.ReadFull(r, result.Payload); err != nil {
+		return nil, err
+	}
+
+	c.setServerSupports(new(FencePseudoEncoding).Type())
+
+	if result.Flags&FenceRequest != 0 {
+		if err := c.Fence(result.Flags&fenceSupportedFlags, result.Payload); err != nil {
+			return nil, err
+		}
+	}
+
+	return &result, nil
 }
@@ -0,0 +1,138 @@
+package vnc
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestFramebufferUpdateMessage_OutsideFramebuffer(t *testing.T) {
+	c := &ClientConn{
+		FrameBufferWidth:  8,
+		FrameBufferHeight: 8,
+		PixelFormat:       PixelFormatRGB888,
+	}
+
+	cases := []struct {
+		update []byte
+		valid  bool
+	}{
+		// A raw rectangle covering the framebuffer
+		{[]byte{0, 0, 1, 0, 0, 0, 0, 0, 8, 0, 8, 0, 0, 0, 0}, true},
+
+		// A raw rectangle past the right edge
+		{[]byte{0, 0, 1, 0, 4, 0, 0, 0, 5, 0, 1, 0, 0, 0, 0}, false},
+
+		// A raw rectangle wrapping around past the bottom edge
+		{[]byte{0, 0, 1, 0, 0, 0xff, 0xff, 0, 1, 0, 2, 0, 0, 0, 0}, false},
+
+		// A desktop size change beyond the current framebuffer
+		{[]byte{0, 0, 1, 0, 0, 0, 0, 0, 16, 0, 16, 0xff, 0xff, 0xff, 0x21}, true},
+	}
+
+	for i, tc := range cases {
+		c.Encs = []Encoding{new(DesktopSizePseudoEncoding)}
+		c.FrameBufferWidth, c.FrameBufferHeight = 8, 8
+
+		pixels := make([]byte, 8*8*4)
+		_, err := new(FramebufferUpdateMessage).Read(c, bytes.NewReader(append(tc.update, pixels...)))
+		if tc.valid && err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if !tc.valid && (err == nil || !strings.Contains(err.Error(), "outside")) {
+			t.Fatalf("%d: expected outside error, got: %v", i, err)
+		}
+	}
+}
+
+func TestSetColorMapEntriesMessage_OutOfRange(t *testing.T) {
+	c := new(ClientConn)
+
+	// Colors 255 and 256
+	data := []byte{0, 0, 255, 0, 2}
+	data = append(data, make([]byte, 12)...)
+	if _, err := new(SetColorMapEntriesMessage).Read(c, bytes.NewReader(data)); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// Color 255 only
+	data = []byte{0, 0, 255, 0, 1, 0xff, 0xff, 0, 0, 0, 0}
+	if _, err := new(SetColorMapEntriesMessage).Read(c, bytes.NewReader(data)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if c.ColorMap[255] != (Color{0xffff, 0, 0}) {
+		t.Fatalf("bad color: %#v", c.ColorMap[255])
+	}
+}
+
+func TestServerCutTextMessage_TooLong(t *testing.T) {
+	c := &ClientConn{config: &ClientConfig{MaxCutTextLength: 4}}
+
+	cases := [][]byte{
+		// 2GB of plain text, and of the extended format
+		{0, 0, 0, 0x7f, 0xff, 0xff, 0xff},
+		{0, 0, 0, 0x80, 0, 0, 0},
+
+		// 5 bytes of plain text, and of the extended format
+		{0, 0, 0, 0, 0, 0, 5, 'h', 'e', 'l', 'l', 'o'},
+		{0, 0, 0, 0xff, 0xff, 0xff, 0xfb, 0, 0, 0, 0, 0},
+	}
+
+	for i, data := range cases {
+		_, err := new(ServerCutTextMessage).Read(c, bytes.NewReader(data))
+		if err == nil || !strings.Contains(err.Error(), "too long") {
+			t.Fatalf("%d: expected too long error, got: %v", i, err)
+		}
+	}
+
+	data := []byte{0, 0, 0, 0, 0, 0, 4, 't', 'e', 'x', 't'}
+	if _, err := new(ServerCutTextMessage).Read(c, bytes.NewReader(data)); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestClient_DesktopNameTooLong(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+
+	go serveTestHandshake(server, 8, 8)
+
+	_, err := Client(client, &ClientConfig{MaxDesktopNameLength: 3})
+	if err == nil || !strings.Contains(err.Error(), "desktop name too long") {
+		t.Fatalf("expected desktop name error, got: %v", err)
+	}
+}
+
+func TestClient_FrameBufferTooLarge(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+
+	go serveTestHandshake(server, 0xffff, 0xffff)
+
+	_, err := Client(client, &ClientConfig{})
+	if err == nil || !strings.Contains(err.Error(), "frame buffer too large") {
+		t.Fatalf("expected frame buffer error, got: %v", err)
+	}
+}
+
+func TestDesktopSizePseudoEncoding_TooLarge(t *testing.T) {
+	c := &ClientConn{
+		config:            &ClientConfig{MaxFrameBufferPixels: 16 * 16},
+		FrameBufferWidth:  8,
+		FrameBufferHeight: 8,
+	}
+
+	if _, err := new(DesktopSizePseudoEncoding).Read(c, &Rectangle{Width: 16, Height: 17}, nil); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if width, height := c.FrameBufferSize(); width != 8 || height != 8 {
+		t.Fatalf("bad size: %dx%d", width, height)
+	}
+
+	if _, err := new(DesktopSizePseudoEncoding).Read(c, &Rectangle{Width: 16, Height: 16}, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
@@ -0,0 +1,274 @@
+package vnc
+
+import (
+	"context"
+	"image"
+	"math"
+	"sort"
+)
+
+// A TemplateMatch is a location on the screen where a template image was
+// found.
+type TemplateMatch struct {
+	// Bounds is the area of the screen that matched the template.
+	Bounds image.Rectangle
+
+	// Score rates the match from 0 to 1, where 1 is a perfect match. It
+	// is one minus the root mean square difference of the color channels,
+	// scaled to the range of a channel.
+	Score float64
+}
+
+// Center returns the center of the match, which is where ClickImage
+// clicks.
+func (m TemplateMatch) Center() image.Point {
+	return image.Pt(
+		(m.Bounds.Min.X+m.Bounds.Max.X)/2,
+		(m.Bounds.Min.Y+m.Bounds.Max.Y)/2)
+}
+
+// TemplateOptions configures the search for a template image.
+type TemplateOptions struct {
+	// MinScore is the minimum score of a match. If this is zero, 0.95 is
+	// used.
+	MinScore float64
+
+	// MaxResults limits the number of matches returned. If this is zero,
+	// 10 is used.
+	MaxResults int
+
+	// Scale is the factor by which the image and the template are scaled
+	// down for a coarse first pass, after which candidates are refined at
+	// full size. If this is zero, it is chosen from the template size.
+	// A scale of 1 compares every position at full size. A scale larger
+	// than the shorter side of the template is reduced to that side.
+	Scale int
+}
+
+// coarseSlack is how much lower than MinScore the score of the coarse
+// pass may be for a candidate to be refined, since scaling down blurs
+// the differences.
+const coarseSlack = 0.1
+
+// FindTemplate searches the image for the template and returns the
+// matches, best first. Matches don't overlap by more than half of the
+// template. Fully transparent pixels of the template match anything.
+// opts may be nil.
+func FindTemplate(img, template image.Image, opts *TemplateOptions) []TemplateMatch {
+	if opts == nil {
+		opts = new(TemplateOptions)
+	}
+
+	minScore := opts.MinScore
+	if minScore == 0 {
+		minScore = 0.95
+	}
+
+	maxResults := opts.MaxResults
+	if maxResults == 0 {
+		maxResults = 10
+	}
+
+	src := toRGBA(img)
+	tmpl := toRGBA(template)
+	tw, th := tmpl.Rect.Dx(), tmpl.Rect.Dy()
+	if tw == 0 || th == 0 || tw > src.Rect.Dx() || th > src.Rect.Dy() {
+		return nil
+	}
+
+	scale := opts.Scale
+	if scale <= 0 {
+		// Keep at least 8 pixels on the shorter side of the template.
+		scale = tw / 8
+		if th < tw {
+			scale = th / 8
+		}
+
+		if scale < 1 {
+			scale = 1
+		} else if scale > 8 {
+			scale = 8
+		}
+	}
+
+	// The scaled down template must keep at least one pixel on each
+	// side, since an empty template matches everywhere.
+	if scale > tw {
+		scale = tw
+	}
+	if scale > th {
+		scale = th
+	}
+
+	// Coarse pass over the scaled down image.
+	var candidates []TemplateMatch
+	coarseMin := minScore
+	if scale > 1 {
+		coarseMin -= coarseSlack
+	}
+
+	smallSrc := downscale(src, scale)
+	smallTmpl := downscale(tmpl, scale)
+	sw, sh := smallTmpl.Rect.Dx(), smallTmpl.Rect.Dy()
+	for y := 0; y <= smallSrc.Rect.Dy()-sh; y++ {
+		for x := 0; x <= smallSrc.Rect.Dx()-sw; x++ {
+			score := templateScore(smallSrc, smallTmpl, image.Pt(x, y))
+			if score >= coarseMin {
+				candidates = append(candidates, TemplateMatch{
+					Bounds: image.Rect(x*scale, y*scale, x*scale+tw, y*scale+th),
+					Score:  score,
+				})
+			}
+		}
+	}
+
+	// Keep the best candidates, then refine each of them at full size in
+	// the area covered by one pixel of the coarse pass.
+	candidates = suppressOverlaps(candidates, maxResults*4)
+	if scale == 1 {
+		return suppressOverlaps(candidates, maxResults)
+	}
+
+	var matches []TemplateMatch
+	for _, cand := range candidates {
+		best := TemplateMatch{Score: -1}
+		for y := cand.Bounds.Min.Y - scale + 1; y < cand.Bounds.Min.Y+scale; y++ {
+			for x := cand.Bounds.Min.X - scale + 1; x < cand.Bounds.Min.X+scale; x++ {
+				bounds := image.Rect(x, y, x+tw, y+th)
+				if !bounds.In(src.Rect) {
+					continue
+				}
+
+				if score := templateScore(src, tmpl, bounds.Min); score > best.Score {
+					best = TemplateMatch{Bounds: bounds, Score: score}
+				}
+			}
+		}
+
+		if best.Score >= minScore {
+			matches = append(matches, best)
+		}
+	}
+
+	return suppressOverlaps(matches, maxResults)
+}
+
+// templateScore compares the template with the image at p. See
+// TemplateMatch.Score.
+func templateScore(img, tmpl *image.RGBA, p image.Point) float64 {
+	w, h := tmpl.Rect.Dx(), tmpl.Rect.Dy()
+
+	var ssd, n int64
+	for y := 0; y < h; y++ {
+		i := img.PixOffset(p.X, p.Y+y)
+		j := tmpl.PixOffset(0, y)
+		for x := 0; x < w; x, i, j = x+1, i+4, j+4 {
+			if tmpl.Pix[j+3] < 0x80 {
+				continue
+			}
+
+			for k := 0; k < 3; k++ {
+				d := int64(img.Pix[i+k]) - int64(tmpl.Pix[j+k])
+				ssd += d * d
+			}
+			n += 3
+		}
+	}
+
+	if n == 0 {
+		return 1
+	}
+
+	return 1 - math.Sqrt(float64(ssd)/float64(n))/0xff
+}
+
+// suppressOverlaps sorts the matches by score and drops matches that
+// overlap a better one by more than half, keeping at most limit matches.
+func suppressOverlaps(matches []TemplateMatch, limit int) []TemplateMatch {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	var result []TemplateMatch
+	for _, m := range matches {
+		if len(result) >= limit {
+			break
+		}
+
+		overlaps := false
+		for _, r := range result {
+			inter := m.Bounds.Intersect(r.Bounds)
+			if 2*inter.Dx()*inter.Dy() > m.Bounds.Dx()*m.Bounds.Dy() {
+				overlaps = true
+				break
+			}
+		}
+
+		if !overlaps {
+			result = append(result, m)
+		}
+	}
+
+	return result
+}
+
+// downscale scales an image down by an integer factor, averaging the
+// pixels of each block.
+func downscale(img *image.RGBA, factor int) *image.RGBA {
+	if factor <= 1 {
+		return img
+	}
+
+	w, h := img.Rect.Dx()/factor, img.Rect.Dy()/factor
+	result := image.NewRGBA(image.Rect(0, 0, w, h))
+	n := factor * factor
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var sum [4]int
+			for dy := 0; dy < factor; dy++ {
+				i := img.PixOffset(img.Rect.Min.X+x*factor, img.Rect.Min.Y+y*factor+dy)
+				for dx := 0; dx < factor; dx, i = dx+1, i+4 {
+					for k := 0; k < 4; k++ {
+						sum[k] += int(img.Pix[i+k])
+					}
+				}
+			}
+
+			j := result.PixOffset(x, y)
+			for k := 0; k < 4; k++ {
+				result.Pix[j+k] = uint8(sum[k] / n)
+			}
+		}
+	}
+
+	return result
+}
+
+// FindImage searches the screen for the template image and returns the
+// matches, best first. See FindTemplate. If no copy of the framebuffer
+// was kept yet, FindImage first waits for the server to send it.
+func (c *ClientConn) FindImage(ctx context.Context, template image.Image, opts *TemplateOptions) ([]TemplateMatch, error) {
+	var matches []TemplateMatch
+	err := c.waitForScreen(ctx, func() bool {
+		matches = FindTemplate(c.Snapshot(), template, opts)
+		return true
+	})
+
+	return matches, err
+}
+
+// ClickImage waits until the template image appears on the screen, and
+// clicks the left button at the center of the best match. It returns the
+// match that was clicked.
+func (c *ClientConn) ClickImage(ctx context.Context, template image.Image) (TemplateMatch, error) {
+	var matches []TemplateMatch
+	err := c.waitForScreen(ctx, func() bool {
+		matches = FindTemplate(c.Snapshot(), template, nil)
+		return len(matches) > 0
+	})
+	if err != nil {
+		return TemplateMatch{}, err
+	}
+
+	return matches[0], c.Click(ButtonLeft, matches[0].Center())
+}
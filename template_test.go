@@ -0,0 +1,132 @@
+package vnc
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// noiseImage returns an image of random pixels, which only matches a
+// template at the exact location it was copied from.
+func noiseImage(w, h int, seed int64) *image.RGBA {
+	rnd := rand.New(rand.NewSource(seed))
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(rnd.Intn(256))
+		if i%4 == 3 {
+			img.Pix[i] = 0xff
+		}
+	}
+
+	return img
+}
+
+func TestFindTemplate(t *testing.T) {
+	img := noiseImage(160, 100, 1)
+	bounds := image.Rect(103, 45, 143, 77)
+	tmpl := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(tmpl, tmpl.Bounds(), img, bounds.Min, draw.Src)
+
+	for _, scale := range []int{0, 1, 3} {
+		matches := FindTemplate(img, tmpl, &TemplateOptions{Scale: scale, MinScore: 0.9})
+		if len(matches) != 1 {
+			t.Fatalf("scale %d: expected 1 match, got %v", scale, matches)
+		}
+
+		if matches[0].Bounds != bounds || matches[0].Score != 1 {
+			t.Fatalf("scale %d: bad match: %v", scale, matches[0])
+		}
+
+		if c := matches[0].Center(); c != image.Pt(123, 61) {
+			t.Fatalf("scale %d: bad center: %s", scale, c)
+		}
+	}
+
+	// A template from another image isn't found
+	other := noiseImage(40, 32, 2)
+	if matches := FindTemplate(img, other, nil); len(matches) != 0 {
+		t.Fatalf("unexpected matches: %v", matches)
+	}
+}
+
+func TestFindTemplate_Multiple(t *testing.T) {
+	img := solidImage(100, 100, color.RGBA{0xff, 0xff, 0xff, 0xff})
+	tmpl := noiseImage(16, 16, 3)
+	positions := []image.Point{{10, 10}, {60, 20}, {30, 70}}
+	for _, p := range positions {
+		draw.Draw(img, tmpl.Bounds().Add(p), tmpl, image.Point{}, draw.Src)
+	}
+
+	matches := FindTemplate(img, tmpl, nil)
+	if len(matches) != len(positions) {
+		t.Fatalf("expected %d matches, got %v", len(positions), matches)
+	}
+
+	found := make(map[image.Point]bool)
+	for _, m := range matches {
+		found[m.Bounds.Min] = true
+	}
+
+	for _, p := range positions {
+		if !found[p] {
+			t.Fatalf("match at %s not found: %v", p, matches)
+		}
+	}
+
+	matches = FindTemplate(img, tmpl, &TemplateOptions{MaxResults: 2})
+	if len(matches) != 2 {
+		t.Fatalf("expected 2 matches, got %v", matches)
+	}
+}
+
+func TestClientConn_ClickImage(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 32, 32)
+	defer conn.Close()
+
+	red := color.RGBA{0xff, 0, 0, 0xff}
+	go serveUpdates(server,
+		rawUpdate(image.Rect(0, 0, 32, 32), color.RGBA{0, 0, 0, 0xff}),
+		rawUpdate(image.Rect(20, 10, 24, 14), red))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tmpl := solidImage(6, 6, color.RGBA{0, 0, 0, 0xff})
+	draw.Draw(tmpl, image.Rect(1, 1, 5, 5), image.NewUniform(red), image.Point{}, draw.Src)
+
+	m, err := conn.ClickImage(ctx, tmpl)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if m.Bounds != image.Rect(19, 9, 25, 15) {
+		t.Fatalf("bad match: %v", m)
+	}
+
+	if p, _ := conn.Pointer(); p != image.Pt(22, 12) {
+		t.Fatalf("bad click position: %s", p)
+	}
+}
+
+func TestFindTemplate_ScaleLargerThanTemplate(t *testing.T) {
+	img := noiseImage(160, 100, 1)
+	bounds := image.Rect(140, 90, 146, 95)
+	tmpl := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(tmpl, tmpl.Bounds(), img, bounds.Min, draw.Src)
+
+	opts := &TemplateOptions{Scale: 16}
+	matches := FindTemplate(img, tmpl, opts)
+	if len(matches) != 1 || matches[0].Bounds != bounds {
+		t.Fatalf("bad matches: %v", matches)
+	}
+
+	// A template from another image isn't found
+	other := noiseImage(6, 5, 2)
+	if matches := FindTemplate(img, other, opts); len(matches) != 0 {
+		t.Fatalf("unexpected matches: %v", matches)
+	}
+}
@@ -0,0 +1,213 @@
+package vnc
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"time"
+)
+
+// ImageMismatchError is returned by WaitForImage if the reference image
+// didn't appear before the context was done.
+type ImageMismatchError struct {
+	// Location is the position of the closest match that was seen.
+	Location image.Point
+
+	// Mismatch is the fraction of pixels that differed at Location.
+	Mismatch float64
+
+	// Diff shows the differences at Location. Differing pixels are red,
+	// and the other pixels are a faded grayscale copy of the screen.
+	Diff *image.RGBA
+
+	// Err is the error of the context.
+	Err error
+}
+
+func (e *ImageMismatchError) Error() string {
+	return fmt.Sprintf("image not found: closest match at %s differs in %.1f%% of pixels: %s",
+		e.Location, e.Mismatch*100, e.Err)
+}
+
+func (e *ImageMismatchError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForImage waits until the reference image appears on the screen
+// within the region, and returns the location of its top-left corner. An
+// empty region searches the whole screen. Reference images are usually
+// loaded from PNG files with the image/png package. Fully transparent
+// pixels of the reference match anything.
+//
+// Every position within the region is compared, so the region should not
+// be much larger than the reference image. Use FindImage to search large
+// areas of the screen.
+//
+// The screen is compared each time a framebuffer update arrives. If no
+// Refresher is running, one is started until WaitForImage returns. If the
+// context is done before the image appears, an *ImageMismatchError is
+// returned.
+func (c *ClientConn) WaitForImage(ctx context.Context, region image.Rectangle, reference image.Image, tolerance ImageTolerance) (image.Point, error) {
+	ref := toRGBA(reference)
+
+	var screen *image.RGBA
+	var best image.Point
+	var mismatch float64
+	err := c.waitForScreen(ctx, func() bool {
+		var ok bool
+		screen = c.snapshotRegion(c.screenRegion(region))
+		best, mismatch, ok = bestMatch(screen, ref, tolerance)
+		return ok
+	})
+
+	if ctxErr := ctx.Err(); ctxErr != nil && err == ctxErr {
+		mismatchErr := &ImageMismatchError{
+			Location: best,
+			Mismatch: mismatch,
+			Err:      err,
+		}
+
+		if screen != nil {
+			mismatchErr.Diff = diffImage(screen, ref, best, tolerance.Color)
+		}
+
+		return best, mismatchErr
+	}
+
+	return best, err
+}
+
+// screenRegion returns the region, or the whole screen if the region is
+// empty.
+func (c *ClientConn) screenRegion(region image.Rectangle) image.Rectangle {
+	if region.Empty() {
+		c.screenLock.Lock()
+		defer c.screenLock.Unlock()
+		return c.screen.Bounds()
+	}
+
+	return region
+}
+
+// waitForScreen calls check each time a framebuffer update has been
+// applied to the local copy of the framebuffer, until it returns true.
+// Updates are requested from the server while waiting.
+func (c *ClientConn) waitForScreen(ctx context.Context, check func() bool) error {
+	newScreen := c.trackScreen()
+
+	updateCh := make(chan struct{}, 1)
+	remove := c.listen(func(msg ServerMessage) {
+		if _, ok := msg.(*FramebufferUpdateMessage); !ok {
+			return
+		}
+
+		select {
+		case updateCh <- struct{}{}:
+		default:
+		}
+	})
+	defer remove()
+
+	stop, err := c.requestUpdates(newScreen)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	// A new copy of the framebuffer is empty until the first update.
+	if !newScreen && check() {
+		return nil
+	}
+
+	for {
+		select {
+		case <-updateCh:
+		case <-c.closed:
+			return ErrClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if check() {
+			return nil
+		}
+	}
+}
+
+// StableOptions configures WaitForStable.
+type StableOptions struct {
+	// IgnoreCursor ignores changes around the pointer position, so that
+	// a cursor drawn into the framebuffer, such as a blinking text
+	// cursor under the pointer, doesn't keep the screen from being
+	// stable.
+	IgnoreCursor bool
+
+	// CursorSize is the distance from the pointer position, in pixels,
+	// within which changes are ignored. If this is zero, 32 is used.
+	CursorSize int
+}
+
+// WaitForStable waits until no pixel within the region has changed for
+// the quiet period. An empty region watches the whole screen. opts may
+// be nil.
+//
+// Changes are seen as framebuffer updates arrive. If no Refresher is
+// running, one is started until WaitForStable returns.
+func (c *ClientConn) WaitForStable(ctx context.Context, quietPeriod time.Duration, region image.Rectangle, opts *StableOptions) error {
+	if opts == nil {
+		opts = new(StableOptions)
+	}
+
+	cursorSize := opts.CursorSize
+	if cursorSize == 0 {
+		cursorSize = 32
+	}
+
+	newScreen := c.trackScreen()
+
+	changeCh := make(chan struct{}, 1)
+	remove := c.listenScreen(func(changed []image.Rectangle) {
+		var cursor image.Rectangle
+		if opts.IgnoreCursor {
+			p, _ := c.Pointer()
+			cursor = image.Rect(p.X-cursorSize, p.Y-cursorSize, p.X+cursorSize, p.Y+cursorSize)
+		}
+
+		for _, r := range changed {
+			if !region.Empty() && !r.Overlaps(region) {
+				continue
+			}
+
+			if opts.IgnoreCursor && r.In(cursor) {
+				continue
+			}
+
+			select {
+			case changeCh <- struct{}{}:
+			default:
+			}
+			return
+		}
+	})
+	defer remove()
+
+	stop, err := c.requestUpdates(newScreen)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	quietUntil := time.Now().Add(quietPeriod)
+	for {
+		select {
+		case <-changeCh:
+			quietUntil = time.Now().Add(quietPeriod)
+		case <-time.After(time.Until(quietUntil)):
+			return nil
+		case <-c.closed:
+			return ErrClosed
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package vnc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// rawUpdate builds a FramebufferUpdate message with a single rectangle of
+// raw pixels of one color, in the pixel format used by newTestClient.
+func rawUpdate(r image.Rectangle, c color.RGBA) []byte {
+	var buf bytes.Buffer
+	buf.Write([]byte{0, 0, 0, 1})
+	binary.Write(&buf, binary.BigEndian, []uint16{
+		uint16(r.Min.X), uint16(r.Min.Y), uint16(r.Dx()), uint16(r.Dy())})
+	binary.Write(&buf, binary.BigEndian, int32(0))
+	for i := 0; i < r.Dx()*r.Dy(); i++ {
+		buf.Write([]byte{c.B, c.G, c.R, 0})
+	}
+
+	return buf.Bytes()
+}
+
+// serveUpdates answers each FramebufferUpdateRequest with the next of the
+// given updates, or with an empty update once they run out.
+func serveUpdates(c net.Conn, updates ...[]byte) {
+	for {
+		var req [10]byte
+		if _, err := io.ReadFull(c, req[:]); err != nil {
+			return
+		}
+
+		update := []byte{0, 0, 0, 0}
+		if len(updates) > 0 {
+			update, updates = updates[0], updates[1:]
+		} else {
+			time.Sleep(time.Millisecond)
+		}
+
+		if _, err := c.Write(update); err != nil {
+			return
+		}
+	}
+}
+
+func solidImage(w, h int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+
+	return img
+}
+
+func TestClientConn_WaitForImage(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 8, 8)
+	defer conn.Close()
+
+	red := color.RGBA{0xff, 0, 0, 0xff}
+	go serveUpdates(server,
+		rawUpdate(image.Rect(0, 0, 8, 8), color.RGBA{0, 0, 0, 0xff}),
+		rawUpdate(image.Rect(3, 2, 5, 4), color.RGBA{0xf8, 0x04, 0, 0xff}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	p, err := conn.WaitForImage(ctx, image.Rectangle{}, solidImage(2, 2, red), ImageTolerance{Color: 8})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if p != image.Pt(3, 2) {
+		t.Fatalf("bad location: %s", p)
+	}
+
+	snapshot := conn.Snapshot()
+	if snapshot.Bounds() != image.Rect(0, 0, 8, 8) {
+		t.Fatalf("bad snapshot bounds: %s", snapshot.Bounds())
+	}
+
+	if c := snapshot.RGBAAt(4, 3); c != (color.RGBA{0xf8, 0x04, 0, 0xff}) {
+		t.Fatalf("bad snapshot pixel: %v", c)
+	}
+}
+
+func TestClientConn_WaitForImageTimeout(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 8, 8)
+	defer conn.Close()
+
+	// Half of the reference matches
+	go serveUpdates(server,
+		rawUpdate(image.Rect(0, 0, 8, 8), color.RGBA{0, 0, 0, 0xff}),
+		rawUpdate(image.Rect(0, 0, 1, 2), color.RGBA{0xff, 0, 0, 0xff}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	ref := solidImage(2, 2, color.RGBA{0xff, 0, 0, 0xff})
+	_, err := conn.WaitForImage(ctx, image.Rect(0, 0, 2, 2), ref, ImageTolerance{Threshold: 0.25})
+
+	var mismatchErr *ImageMismatchError
+	if !errors.As(err, &mismatchErr) {
+		t.Fatalf("expected ImageMismatchError, got: %v", err)
+	}
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got: %v", mismatchErr.Err)
+	}
+
+	if mismatchErr.Mismatch != 0.5 || mismatchErr.Location != image.Pt(0, 0) {
+		t.Fatalf("bad mismatch: %v at %s", mismatchErr.Mismatch, mismatchErr.Location)
+	}
+
+	if c := mismatchErr.Diff.RGBAAt(1, 0); c != (color.RGBA{0xff, 0, 0, 0xff}) {
+		t.Fatalf("differing pixel should be red: %v", c)
+	}
+
+	if c := mismatchErr.Diff.RGBAAt(0, 0); c.R != c.G {
+		t.Fatalf("matching pixel should be gray: %v", c)
+	}
+}
+
+func TestClientConn_WaitForStable(t *testing.T) {
+	conn, server := newTestClient(t, &ClientConfig{}, 64, 64)
+	defer conn.Close()
+
+	black := color.RGBA{0, 0, 0, 0xff}
+	white := color.RGBA{0xff, 0xff, 0xff, 0xff}
+
+	// The region keeps changing for the first updates, while the area
+	// outside of it changes forever.
+	updates := [][]byte{rawUpdate(image.Rect(0, 0, 64, 64), black)}
+	for i := 0; i < 10; i++ {
+		c := black
+		if i%2 == 0 {
+			c = white
+		}
+		updates = append(updates, rawUpdate(image.Rect(0, 0, 4, 4), c))
+	}
+	for i := 0; i < 1000; i++ {
+		c := black
+		if i%2 == 0 {
+			c = white
+		}
+		updates = append(updates, rawUpdate(image.Rect(40, 40, 44, 44), c))
+	}
+
+	go func() {
+		for {
+			// Skip pointer events, which are sent by MoveTo below
+			var req [10]byte
+			if _, err := io.ReadFull(server, req[:6]); err != nil {
+				return
+			}
+			if req[0] == 5 {
+				continue
+			}
+			if _, err := io.ReadFull(server, req[6:]); err != nil {
+				return
+			}
+
+			update := []byte{0, 0, 0, 0}
+			if len(updates) > 0 {
+				update, updates = updates[0], updates[1:]
+			}
+
+			time.Sleep(5 * time.Millisecond)
+			if _, err := server.Write(update); err != nil {
+				return
+			}
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The quiet period is much longer than the interval of the updates,
+	// so that slow scheduling doesn't pass for a stable screen.
+	start := time.Now()
+	if err := conn.WaitForStable(ctx, 150*time.Millisecond, image.Rect(0, 0, 32, 32), nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The region changes for the first 11 updates, which take at least
+	// 55ms, and then stays the same for the quiet period of 150ms
+	if d := time.Since(start); d < 200*time.Millisecond {
+		t.Fatalf("returned too early: %s", d)
+	}
+
+	// The area around the cursor is ignored
+	conn.MoveTo(image.Pt(42, 42))
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := conn.WaitForStable(ctx, 50*time.Millisecond, image.Rectangle{}, nil); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got: %v", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	opts := &StableOptions{IgnoreCursor: true, CursorSize: 8}
+	if err := conn.WaitForStable(ctx, 50*time.Millisecond, image.Rectangle{}, opts); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestClientConn_drawImageChangedRuns(t *testing.T) {
+	c := &ClientConn{FrameBufferWidth: 8, FrameBufferHeight: 8}
+	c.trackScreen()
+
+	black := color.RGBA{0, 0, 0, 0xff}
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	draw.Draw(img, img.Rect, image.NewUniform(black), image.Point{}, draw.Src)
+	if changed := c.drawImage(img); len(changed) != 1 || changed[0] != img.Rect {
+		t.Fatalf("bad changed areas: %v", changed)
+	}
+
+	// Opposite corners, and a block in the middle
+	white := color.RGBA{0xff, 0xff, 0xff, 0xff}
+	img.SetRGBA(0, 0, white)
+	img.SetRGBA(7, 7, white)
+	for y := 3; y < 5; y++ {
+		for x := 3; x < 5; x++ {
+			img.SetRGBA(x, y, white)
+		}
+	}
+
+	changed := c.drawImage(img)
+	expected := []image.Rectangle{
+		image.Rect(0, 0, 1, 1),
+		image.Rect(3, 3, 5, 5),
+		image.Rect(7, 7, 8, 8),
+	}
+	if !reflect.DeepEqual(changed, expected) {
+		t.Fatalf("bad changed areas: %v", changed)
+	}
+
+	// The colors of a RawEncoding are compared the same way
+	c.PixelFormat = PixelFormatRGB888
+	colors := make([]Color, 64)
+	colors[6] = Color{0xff, 0xff, 0xff}
+	changed = c.drawColors(&Rectangle{Width: 8, Height: 8}, colors)
+	expected = []image.Rectangle{
+		image.Rect(0, 0, 1, 1),
+		image.Rect(6, 0, 7, 1),
+		image.Rect(3, 3, 5, 5),
+		image.Rect(7, 7, 8, 8),
+	}
+	if !reflect.DeepEqual(changed, expected) {
+		t.Fatalf("bad changed areas: %v", changed)
+	}
+}